@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// RiskAssessment records who assessed a risk, the levels they chose and why.
+// It is an immutable value object.
+type RiskAssessment struct {
+	riskID     shared.RiskID
+	assessorID shared.UserID
+	score      RiskScore
+	rationale  string
+	assessedAt time.Time
+}
+
+// Getter methods
+func (a RiskAssessment) RiskID() shared.RiskID     { return a.riskID }
+func (a RiskAssessment) AssessorID() shared.UserID { return a.assessorID }
+func (a RiskAssessment) Likelihood() RiskLevel     { return a.score.likelihood }
+func (a RiskAssessment) Impact() RiskLevel         { return a.score.impact }
+func (a RiskAssessment) Score() RiskScore          { return a.score }
+func (a RiskAssessment) Rationale() string         { return a.rationale }
+func (a RiskAssessment) AssessedAt() time.Time     { return a.assessedAt }
+
+// CreateRiskAssessmentInput holds the input for creating a RiskAssessment.
+type CreateRiskAssessmentInput struct {
+	RiskID     shared.RiskID
+	AssessorID shared.UserID
+	Likelihood RiskLevel
+	Impact     RiskLevel
+	Rationale  string
+	AssessedAt time.Time
+	Scoring    RiskScoringPolicy // nil means DefaultRiskMatrix
+}
+
+// NewRiskAssessment creates a RiskAssessment with validation.
+func NewRiskAssessment(input CreateRiskAssessmentInput) (RiskAssessment, error) {
+	var errors shared.ValidationErrors
+
+	if input.RiskID == "" {
+		errors.Add("riskId", "Risk ID is required", "REQUIRED")
+	}
+
+	if input.AssessorID == "" {
+		errors.Add("assessorId", "Assessor is required", "REQUIRED")
+	}
+
+	scoring := input.Scoring
+	if scoring == nil {
+		scoring = DefaultRiskMatrix()
+	}
+
+	validateRiskLevels(&errors, scoring, input.Likelihood, input.Impact)
+
+	if input.Rationale == "" {
+		errors.Add("rationale", "Assessment rationale is required", "REQUIRED")
+	}
+
+	if input.AssessedAt.IsZero() {
+		errors.Add("assessedAt", "Assessment date is required", "REQUIRED")
+	}
+
+	if errors.HasErrors() {
+		return RiskAssessment{}, errors
+	}
+
+	return RiskAssessment{
+		riskID:     input.RiskID,
+		assessorID: input.AssessorID,
+		score:      CalculateRiskScoreWith(scoring, input.Likelihood, input.Impact),
+		rationale:  input.Rationale,
+		assessedAt: input.AssessedAt,
+	}, nil
+}
+
+// Assess transitions the risk to Assessed using the assessment's assessor
+// and timestamp, and re-scores its inherent risk with the assessment's
+// likelihood and impact under the risk's own scoring policy. The residual
+// score is lowered to the new inherent score if it would exceed it.
+// The assessment must be for this risk.
+func (r *Risk) Assess(a RiskAssessment) (*Risk, error) {
+	if a.riskID != r.id {
+		return nil, shared.NewValidationError(
+			"riskId",
+			fmt.Sprintf("Assessment is for risk %s, not %s", a.riskID, r.id),
+			"ASSESSMENT_RISK_MISMATCH",
+		)
+	}
+
+	var errors shared.ValidationErrors
+	validateRiskLevels(&errors, r.scoring, a.score.likelihood, a.score.impact)
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	updated, err := r.WithStatusAt(
+		shared.FixedClock{Time: a.assessedAt},
+		Assessed{AssessedAt: a.assessedAt, AssessorID: a.assessorID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updated.inherentScore = CalculateRiskScoreWith(r.scoring, a.score.likelihood, a.score.impact)
+	if updated.residualScore.value > updated.inherentScore.value {
+		updated.residualScore = updated.inherentScore
+	}
+	return updated, nil
+}
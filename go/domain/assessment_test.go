@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestNewRiskAssessmentValidation(t *testing.T) {
+	valid := CreateRiskAssessmentInput{
+		RiskID:     "risk-1",
+		AssessorID: "user-1",
+		Likelihood: RiskLevelHigh,
+		Impact:     RiskLevelMedium,
+		Rationale:  "Internet-facing and unpatched",
+		AssessedAt: testNow,
+	}
+
+	tests := []struct {
+		name       string
+		mutate     func(in *CreateRiskAssessmentInput)
+		wantFields []string
+	}{
+		{"valid", func(*CreateRiskAssessmentInput) {}, nil},
+		{"missing rationale", func(in *CreateRiskAssessmentInput) { in.Rationale = "" }, []string{"rationale"}},
+		{"likelihood out of range", func(in *CreateRiskAssessmentInput) { in.Likelihood = 7 }, []string{"likelihood"}},
+		{"everything missing", func(in *CreateRiskAssessmentInput) { *in = CreateRiskAssessmentInput{} },
+			[]string{"riskId", "assessorId", "rationale", "assessedAt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := valid
+			tt.mutate(&in)
+			a, err := NewRiskAssessment(in)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("NewRiskAssessment() error = %v", err)
+				}
+				if a.Score().Value() != 6 || a.Rationale() != valid.Rationale {
+					t.Errorf("NewRiskAssessment() = score %d, %q", a.Score().Value(), a.Rationale())
+				}
+				return
+			}
+			var errs shared.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("NewRiskAssessment() error = %v, want ValidationErrors", err)
+			}
+			for _, field := range tt.wantFields {
+				if len(errs.FieldErrors(field)) == 0 {
+					t.Errorf("NewRiskAssessment() error = %v, want an error on %s", err, field)
+				}
+			}
+		})
+	}
+}
+
+func TestRiskAssess(t *testing.T) {
+	assessedAt := testNow.Add(time.Hour)
+	a, err := NewRiskAssessment(CreateRiskAssessmentInput{
+		RiskID: "risk-1", AssessorID: "user-2", Likelihood: RiskLevelLow, Impact: RiskLevelLow,
+		Rationale: "Compensating controls in place", AssessedAt: assessedAt,
+	})
+	if err != nil {
+		t.Fatalf("NewRiskAssessment() error = %v", err)
+	}
+
+	r, err := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh).Assess(a)
+	if err != nil {
+		t.Fatalf("Assess() error = %v", err)
+	}
+	if s, ok := r.Status().(Assessed); !ok || s.AssessorID != "user-2" || !s.AssessedAt.Equal(assessedAt) {
+		t.Errorf("Status() = %v, want Assessed by user-2 at %v", r.Status(), assessedAt)
+	}
+	if s := r.InherentScore(); s.Likelihood() != RiskLevelLow || s.Impact() != RiskLevelLow || s.Value() != 1 {
+		t.Errorf("InherentScore() = %s×%s (%d), want Low×Low (1)", s.Likelihood(), s.Impact(), s.Value())
+	}
+	if s := r.ResidualScore(); !s.Equal(r.InherentScore()) {
+		t.Errorf("ResidualScore() = %d, want it lowered to the assessed %d", s.Value(), r.InherentScore().Value())
+	}
+
+	if _, err := newTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh).Assess(a); !hasCode(err, "ASSESSMENT_RISK_MISMATCH") {
+		t.Errorf("Assess() on another risk error = %v, want ASSESSMENT_RISK_MISMATCH", err)
+	}
+}
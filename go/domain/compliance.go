@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// ComplianceHealth is the rolled-up health level of a framework.
+type ComplianceHealth string
+
+const (
+	ComplianceHealthGreen  ComplianceHealth = "Green"
+	ComplianceHealthYellow ComplianceHealth = "Yellow"
+	ComplianceHealthRed    ComplianceHealth = "Red"
+)
+
+// ComplianceThresholds holds the minimum implemented percentage for each health level.
+// Anything below Yellow is Red.
+type ComplianceThresholds struct {
+	Green  int
+	Yellow int
+}
+
+// DefaultComplianceThresholds is Green at 90% and Yellow at 70%.
+var DefaultComplianceThresholds = ComplianceThresholds{Green: 90, Yellow: 70}
+
+// FrameworkCompliance is the rolled-up compliance status of a framework.
+type FrameworkCompliance struct {
+	FrameworkID shared.FrameworkID
+	// StatusCounts holds the number of controls per status kind (see ControlStatusKind).
+	StatusCounts map[string]int
+	// Implemented is the share of applicable controls that are Implemented.
+	// NotApplicable controls are excluded from the denominator.
+	Implemented shared.Percentage
+	Health      ComplianceHealth
+	// MissingControlIDs lists framework control IDs without a matching Control.
+	MissingControlIDs []shared.ControlID
+}
+
+// ComputeFrameworkCompliance rolls up the given controls into a single
+// compliance status using DefaultComplianceThresholds.
+func ComputeFrameworkCompliance(f *Framework, controls []*Control) FrameworkCompliance {
+	return ComputeFrameworkComplianceWith(f, controls, DefaultComplianceThresholds)
+}
+
+// ComputeFrameworkComplianceWith rolls up the given controls into a single
+// compliance status. Only controls whose ID is in the framework's ControlIDs
+// are counted. With no applicable controls the implemented percentage is 0.
+func ComputeFrameworkComplianceWith(
+	f *Framework,
+	controls []*Control,
+	thresholds ComplianceThresholds,
+) FrameworkCompliance {
+	byID := make(map[shared.ControlID]*Control, len(controls))
+	for _, c := range controls {
+		byID[c.id] = c
+	}
+
+	counts := make(map[string]int)
+	var missing []shared.ControlID
+	for _, id := range f.controlIDs {
+		c, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		counts[ControlStatusKind(c.status)]++
+	}
+
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	applicable := total - counts[ControlStatusNotApplicable]
+
+	percentage := 0
+	if applicable > 0 {
+		percentage = counts[ControlStatusImplemented] * 100 / applicable
+	}
+	implemented, _ := shared.NewPercentage(percentage)
+
+	health := ComplianceHealthRed
+	switch {
+	case percentage >= thresholds.Green:
+		health = ComplianceHealthGreen
+	case percentage >= thresholds.Yellow:
+		health = ComplianceHealthYellow
+	}
+
+	return FrameworkCompliance{
+		FrameworkID:       f.id,
+		StatusCounts:      counts,
+		Implemented:       implemented,
+		Health:            health,
+		MissingControlIDs: missing,
+	}
+}
+
+// LibraryCoverage counts how many frameworks reference each control in the library.
+// Controls that no framework references are included with a count of zero.
+func LibraryCoverage(library []*Control, frameworks []*Framework) map[shared.ControlID]int {
+	coverage := make(map[shared.ControlID]int, len(library))
+	for _, c := range library {
+		coverage[c.id] = 0
+	}
+	for _, f := range frameworks {
+		for _, id := range f.controlIDs {
+			if _, ok := coverage[id]; ok {
+				coverage[id]++
+			}
+		}
+	}
+	return coverage
+}
+
+// UnusedLibraryControls returns the library controls that no framework references,
+// in library order.
+func UnusedLibraryControls(library []*Control, frameworks []*Framework) []*Control {
+	coverage := LibraryCoverage(library, frameworks)
+	var unused []*Control
+	for _, c := range library {
+		if coverage[c.id] == 0 {
+			unused = append(unused, c)
+		}
+	}
+	return unused
+}
+
+// ControlCoverage reports whether a control is backed by valid evidence.
+type ControlCoverage struct {
+	ControlID shared.ControlID
+	Covered   bool
+	// Applicable is false for NotApplicable controls, which are excluded
+	// from the coverage percentage.
+	Applicable bool
+}
+
+// Coverage describes how much of a framework is backed by valid evidence.
+type Coverage struct {
+	FrameworkID shared.FrameworkID
+	// Controls lists the framework's controls in framework order. Framework
+	// control IDs without a matching Control are omitted.
+	Controls []ControlCoverage
+	// Percentage is the share of applicable controls that are covered.
+	Percentage shared.Percentage
+}
+
+// FrameworkCoverage reports, per control, whether it has at least one Valid
+// (non-expired, non-rejected) piece of evidence, as of the current time.
+func FrameworkCoverage(f *Framework, controls []*Control, evidence []*Evidence) Coverage {
+	return FrameworkCoverageAt(f, controls, evidence, time.Now())
+}
+
+// FrameworkCoverageAt is like FrameworkCoverage but evaluates evidence at the given time.
+// With no applicable controls the percentage is 0.
+func FrameworkCoverageAt(f *Framework, controls []*Control, evidence []*Evidence, now time.Time) Coverage {
+	byID := make(map[shared.ControlID]*Control, len(controls))
+	for _, c := range controls {
+		byID[c.id] = c
+	}
+	evidenceByControl := make(map[shared.ControlID][]*Evidence)
+	for _, e := range evidence {
+		evidenceByControl[e.controlID] = append(evidenceByControl[e.controlID], e)
+	}
+
+	var result []ControlCoverage
+	applicable, covered := 0, 0
+	for _, id := range f.controlIDs {
+		c, ok := byID[id]
+		if !ok {
+			continue
+		}
+		_, isNA := c.status.(NotApplicable)
+		cc := ControlCoverage{
+			ControlID:  id,
+			Covered:    hasValidEvidence(evidenceByControl[id], now),
+			Applicable: !isNA,
+		}
+		if cc.Applicable {
+			applicable++
+			if cc.Covered {
+				covered++
+			}
+		}
+		result = append(result, cc)
+	}
+
+	percentage := 0
+	if applicable > 0 {
+		percentage = covered * 100 / applicable
+	}
+	pct, _ := shared.NewPercentage(percentage)
+
+	return Coverage{
+		FrameworkID: f.id,
+		Controls:    result,
+		Percentage:  pct,
+	}
+}
+
+// AuditSnapshot bundles a framework and its controls at a point in time.
+type AuditSnapshot struct {
+	Framework *Framework
+	Controls  []*Control
+	TakenAt   time.Time
+}
+
+// AuditComparison describes how compliance posture changed between two snapshots.
+type AuditComparison struct {
+	Before FrameworkCompliance
+	After  FrameworkCompliance
+	// CoverageChange is the change in implemented percentage points.
+	CoverageChange int
+	// NewlyImplemented lists controls Implemented after but not before.
+	NewlyImplemented []shared.ControlID
+	// NewlyFailed lists controls Failed after but not before.
+	NewlyFailed []shared.ControlID
+	// BandMovement is the number of health levels moved: positive towards
+	// Green, negative towards Red.
+	BandMovement int
+}
+
+// Improved returns true if the health band moved towards Green, or stayed
+// the same while coverage increased.
+func (c AuditComparison) Improved() bool {
+	return c.BandMovement > 0 || (c.BandMovement == 0 && c.CoverageChange > 0)
+}
+
+// CompareAuditSnapshots compares the compliance posture of two snapshots.
+func CompareAuditSnapshots(before, after AuditSnapshot) AuditComparison {
+	beforeCompliance := ComputeFrameworkCompliance(before.Framework, before.Controls)
+	afterCompliance := ComputeFrameworkCompliance(after.Framework, after.Controls)
+
+	previous := make(map[shared.ControlID]string, len(before.Controls))
+	for _, c := range before.Controls {
+		previous[c.id] = ControlStatusKind(c.status)
+	}
+
+	var newlyImplemented, newlyFailed []shared.ControlID
+	for _, c := range after.Controls {
+		kind := ControlStatusKind(c.status)
+		if kind == previous[c.id] {
+			continue
+		}
+		switch kind {
+		case ControlStatusImplemented:
+			newlyImplemented = append(newlyImplemented, c.id)
+		case ControlStatusFailed:
+			newlyFailed = append(newlyFailed, c.id)
+		}
+	}
+
+	return AuditComparison{
+		Before:           beforeCompliance,
+		After:            afterCompliance,
+		CoverageChange:   afterCompliance.Implemented.Value() - beforeCompliance.Implemented.Value(),
+		NewlyImplemented: newlyImplemented,
+		NewlyFailed:      newlyFailed,
+		BandMovement:     healthRank(afterCompliance.Health) - healthRank(beforeCompliance.Health),
+	}
+}
+
+func healthRank(h ComplianceHealth) int {
+	switch h {
+	case ComplianceHealthGreen:
+		return 2
+	case ComplianceHealthYellow:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestComputeFrameworkCompliance(t *testing.T) {
+	implemented := Implemented{ImplementedAt: testNow}
+	controls := []*Control{
+		newTestControl(t, "ctrl-1", implemented),
+		newTestControl(t, "ctrl-2", implemented),
+		newTestControl(t, "ctrl-3", implemented),
+		newTestControl(t, "ctrl-4", Failed{Reason: "audit", DetectedAt: testNow}),
+		newTestControl(t, "ctrl-5", NotApplicable{Reason: "no cardholder data"}),
+		newTestControl(t, "ctrl-other", implemented), // not in the framework
+	}
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2", "ctrl-3", "ctrl-4", "ctrl-5", "ctrl-6")
+
+	got := ComputeFrameworkCompliance(f, controls)
+
+	// 3 of 4 applicable controls: NotApplicable is excluded from the denominator
+	if got.Implemented.Value() != 75 {
+		t.Errorf("Implemented = %s, want 75%%", got.Implemented)
+	}
+	if got.Health != ComplianceHealthYellow {
+		t.Errorf("Health = %s, want Yellow", got.Health)
+	}
+	wantCounts := map[string]int{
+		ControlStatusImplemented:   3,
+		ControlStatusFailed:        1,
+		ControlStatusNotApplicable: 1,
+	}
+	for kind, want := range wantCounts {
+		if got.StatusCounts[kind] != want {
+			t.Errorf("StatusCounts[%s] = %d, want %d", kind, got.StatusCounts[kind], want)
+		}
+	}
+	if !slices.Equal(got.MissingControlIDs, []shared.ControlID{"ctrl-6"}) {
+		t.Errorf("MissingControlIDs = %v, want [ctrl-6]", got.MissingControlIDs)
+	}
+}
+
+func TestComputeFrameworkComplianceAllNotApplicable(t *testing.T) {
+	controls := []*Control{newTestControl(t, "ctrl-1", NotApplicable{Reason: "out of scope"})}
+	f := newTestFramework(t, "fw-1", "ctrl-1")
+
+	got := ComputeFrameworkCompliance(f, controls)
+
+	if got.Implemented.Value() != 0 || got.Health != ComplianceHealthRed {
+		t.Errorf("Implemented, Health = %s, %s, want 0%%, Red", got.Implemented, got.Health)
+	}
+}
+
+func TestLibraryCoverage(t *testing.T) {
+	library := []*Control{
+		newTestControl(t, "ctrl-shared"),
+		newTestControl(t, "ctrl-single"),
+		newTestControl(t, "ctrl-dead"),
+	}
+	frameworks := []*Framework{
+		newTestFramework(t, "fw-1", "ctrl-shared", "ctrl-single"),
+		newTestFramework(t, "fw-2", "ctrl-shared", "ctrl-elsewhere"),
+	}
+
+	coverage := LibraryCoverage(library, frameworks)
+
+	want := map[shared.ControlID]int{"ctrl-shared": 2, "ctrl-single": 1, "ctrl-dead": 0}
+	if len(coverage) != len(want) {
+		t.Errorf("LibraryCoverage() = %v, want %v", coverage, want)
+	}
+	for id, n := range want {
+		if coverage[id] != n {
+			t.Errorf("coverage[%s] = %d, want %d", id, coverage[id], n)
+		}
+	}
+
+	unused := UnusedLibraryControls(library, frameworks)
+	if len(unused) != 1 || unused[0].ID() != "ctrl-dead" {
+		t.Errorf("UnusedLibraryControls() = %v, want [ctrl-dead]", unused)
+	}
+}
+
+func TestCompareAuditSnapshotsImprovement(t *testing.T) {
+	implemented := Implemented{ImplementedAt: testNow}
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2", "ctrl-3", "ctrl-4")
+	before := AuditSnapshot{
+		Framework: f,
+		Controls: []*Control{
+			newTestControl(t, "ctrl-1", implemented),
+			newTestControl(t, "ctrl-2", implemented),
+			newTestControl(t, "ctrl-3", InProgress{}),
+			newTestControl(t, "ctrl-4"),
+		},
+		TakenAt: testNow,
+	}
+	after := AuditSnapshot{
+		Framework: f,
+		Controls: []*Control{
+			before.Controls[0],
+			before.Controls[1],
+			newTestControl(t, "ctrl-3", implemented),
+			newTestControl(t, "ctrl-4", Failed{Reason: "audit", DetectedAt: testNow}),
+		},
+		TakenAt: testNow.Add(90 * 24 * time.Hour),
+	}
+
+	got := CompareAuditSnapshots(before, after)
+
+	if got.Before.Health != ComplianceHealthRed || got.After.Health != ComplianceHealthYellow {
+		t.Errorf("health = %s -> %s, want Red -> Yellow", got.Before.Health, got.After.Health)
+	}
+	if got.CoverageChange != 25 || got.BandMovement != 1 {
+		t.Errorf("CoverageChange, BandMovement = %d, %d, want 25, 1", got.CoverageChange, got.BandMovement)
+	}
+	if !slices.Equal(got.NewlyImplemented, []shared.ControlID{"ctrl-3"}) {
+		t.Errorf("NewlyImplemented = %v, want [ctrl-3]", got.NewlyImplemented)
+	}
+	if !slices.Equal(got.NewlyFailed, []shared.ControlID{"ctrl-4"}) {
+		t.Errorf("NewlyFailed = %v, want [ctrl-4]", got.NewlyFailed)
+	}
+	if !got.Improved() {
+		t.Error("Improved() = false, want true")
+	}
+	if CompareAuditSnapshots(after, before).Improved() {
+		t.Error("Improved() = true for the reverse comparison")
+	}
+}
+
+func TestFrameworkCoverageAt(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2", "ctrl-3", "ctrl-missing")
+	controls := []*Control{
+		newTestControl(t, "ctrl-1"),
+		newTestControl(t, "ctrl-2"),
+		newTestControl(t, "ctrl-3", NotApplicable{Reason: "no cardholder data"}),
+	}
+	lastYear := testNow.AddDate(-1, 0, 0)
+	evidence := []*Evidence{
+		newTestEvidence(t, "ev-1", "ctrl-1", testNow, nil),
+		newTestEvidence(t, "ev-2", "ctrl-2", lastYear, timePtr(lastYear.AddDate(0, 6, 0))),
+		newTestEvidence(t, "ev-3", "ctrl-3", testNow, nil),
+	}
+
+	got := FrameworkCoverageAt(f, controls, evidence, testNow)
+
+	want := []ControlCoverage{
+		{ControlID: "ctrl-1", Covered: true, Applicable: true},
+		{ControlID: "ctrl-2", Covered: false, Applicable: true},
+		{ControlID: "ctrl-3", Covered: true, Applicable: false},
+	}
+	if !slices.Equal(got.Controls, want) {
+		t.Errorf("Controls = %+v, want %+v", got.Controls, want)
+	}
+	if got.Percentage.Value() != 50 {
+		t.Errorf("Percentage = %d, want 50", got.Percentage.Value())
+	}
+	if f.ControlCount() != 4 {
+		t.Errorf("ControlCount() = %d, want 4", f.ControlCount())
+	}
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// Inconsistency codes reported by ConsistencyReport.
+const (
+	InconsistencyImplementedNoEvidence = "IMPLEMENTED_NO_EVIDENCE"
+	InconsistencyFailedWithEvidence    = "FAILED_WITH_EVIDENCE"
+	InconsistencyNAWithEvidence        = "NA_WITH_EVIDENCE"
+)
+
+// Inconsistency describes a mismatch between a control's status and its evidence.
+type Inconsistency struct {
+	ControlID shared.ControlID
+	Code      string
+	Message   string
+}
+
+func (i Inconsistency) String() string {
+	return fmt.Sprintf("[%s] %s: %s", i.Code, i.ControlID, i.Message)
+}
+
+// ConsistencyReport checks each control's status against its evidence at the given time.
+// It flags Implemented controls without valid evidence, Failed controls whose
+// evidence is all valid, and NotApplicable controls that have evidence.
+func ConsistencyReport(
+	controls []*Control,
+	evidenceByControl map[shared.ControlID][]*Evidence,
+	now time.Time,
+) []Inconsistency {
+	var report []Inconsistency
+
+	for _, c := range controls {
+		evidence := evidenceByControl[c.id]
+
+		validCount := 0
+		for _, e := range evidence {
+			if e.StatusAt(now, 0) == EvidenceStatusValid {
+				validCount++
+			}
+		}
+
+		switch c.status.(type) {
+		case Implemented:
+			if validCount == 0 {
+				report = append(report, Inconsistency{
+					ControlID: c.id,
+					Code:      InconsistencyImplementedNoEvidence,
+					Message:   "Control is implemented but has no valid evidence",
+				})
+			}
+		case Failed:
+			if len(evidence) > 0 && validCount == len(evidence) {
+				report = append(report, Inconsistency{
+					ControlID: c.id,
+					Code:      InconsistencyFailedWithEvidence,
+					Message:   "Control is failed but all of its evidence is valid",
+				})
+			}
+		case NotApplicable:
+			if len(evidence) > 0 {
+				report = append(report, Inconsistency{
+					ControlID: c.id,
+					Code:      InconsistencyNAWithEvidence,
+					Message:   fmt.Sprintf("Control is not applicable but has %d evidence item(s)", len(evidence)),
+				})
+			}
+		}
+	}
+
+	return report
+}
+
+// EvidenceExpiredReason is the Failed reason set by PropagateEvidenceExpiry.
+const EvidenceExpiredReason = "evidence expired"
+
+// PropagateEvidenceExpiry fails every Implemented control that has no valid
+// evidence at the given time, using EvidenceExpiredReason. Other controls are
+// returned untouched, in the same order.
+func PropagateEvidenceExpiry(
+	controls []*Control,
+	evidenceByControl map[shared.ControlID][]*Evidence,
+	now time.Time,
+) []*Control {
+	result := make([]*Control, len(controls))
+	for i, c := range controls {
+		result[i] = c
+		if _, ok := c.status.(Implemented); !ok || hasValidEvidence(evidenceByControl[c.id], now) {
+			continue
+		}
+		failed, err := c.WithStatusAt(shared.FixedClock{Time: now}, Failed{Reason: EvidenceExpiredReason, DetectedAt: now})
+		if err == nil {
+			result[i] = failed
+		}
+	}
+	return result
+}
+
+func hasValidEvidence(evidence []*Evidence, now time.Time) bool {
+	for _, e := range evidence {
+		if e.StatusAt(now, 0) == EvidenceStatusValid {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateEvidenceForControl checks that the evidence is filed against the
+// given control and that the control is not NotApplicable, since evidence is
+// not collected for controls out of scope.
+func ValidateEvidenceForControl(e *Evidence, c *Control) error {
+	var errors shared.ValidationErrors
+
+	if e.controlID != c.id {
+		errors.Add(
+			"controlId",
+			fmt.Sprintf("Evidence %s is filed against control %s, not %s", e.id, e.controlID, c.id),
+			"EVIDENCE_CONTROL_MISMATCH",
+		)
+	}
+
+	if _, ok := c.status.(NotApplicable); ok {
+		errors.Add(
+			"controlId",
+			fmt.Sprintf("Control %s is not applicable and does not accept evidence", c.id),
+			"EVIDENCE_FOR_NA_CONTROL",
+		)
+	}
+
+	return errors.ToError()
+}
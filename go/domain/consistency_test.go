@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestConsistencyReport(t *testing.T) {
+	past := testNow.Add(-48 * time.Hour)
+	expired := timePtr(testNow.Add(-time.Hour))
+	valid := timePtr(testNow.Add(24 * time.Hour))
+
+	tests := []struct {
+		name     string
+		control  *Control
+		evidence []*Evidence
+		wantCode string // "" means consistent
+	}{
+		{
+			name:     "implemented with valid evidence",
+			control:  newTestControl(t, "ctrl-1", Implemented{ImplementedAt: testNow}),
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", past, valid)},
+		},
+		{
+			name:     "implemented with only expired evidence",
+			control:  newTestControl(t, "ctrl-1", Implemented{ImplementedAt: testNow}),
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", past, expired)},
+			wantCode: InconsistencyImplementedNoEvidence,
+		},
+		{
+			name:     "implemented without evidence",
+			control:  newTestControl(t, "ctrl-1", Implemented{ImplementedAt: testNow}),
+			wantCode: InconsistencyImplementedNoEvidence,
+		},
+		{
+			name:     "failed with all evidence valid",
+			control:  newTestControl(t, "ctrl-1", Failed{Reason: "audit", DetectedAt: testNow}),
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", past, nil)},
+			wantCode: InconsistencyFailedWithEvidence,
+		},
+		{
+			name:    "failed with some expired evidence",
+			control: newTestControl(t, "ctrl-1", Failed{Reason: "audit", DetectedAt: testNow}),
+			evidence: []*Evidence{
+				newTestEvidence(t, "ev-1", "ctrl-1", past, nil),
+				newTestEvidence(t, "ev-2", "ctrl-1", past, expired),
+			},
+		},
+		{
+			name:     "not applicable with evidence",
+			control:  newTestControl(t, "ctrl-1", NotApplicable{Reason: "no cloud"}),
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", past, nil)},
+			wantCode: InconsistencyNAWithEvidence,
+		},
+		{
+			name:    "not applicable without evidence",
+			control: newTestControl(t, "ctrl-1", NotApplicable{Reason: "no cloud"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := ConsistencyReport(
+				[]*Control{tt.control},
+				map[shared.ControlID][]*Evidence{tt.control.ID(): tt.evidence},
+				testNow,
+			)
+			if tt.wantCode == "" {
+				if len(report) != 0 {
+					t.Fatalf("ConsistencyReport() = %v, want none", report)
+				}
+				return
+			}
+			if len(report) != 1 {
+				t.Fatalf("ConsistencyReport() = %v, want one %s", report, tt.wantCode)
+			}
+			if report[0].Code != tt.wantCode || report[0].ControlID != tt.control.ID() {
+				t.Errorf("ConsistencyReport() = %v, want %s for %s", report[0], tt.wantCode, tt.control.ID())
+			}
+		})
+	}
+}
+
+func TestPropagateEvidenceExpiry(t *testing.T) {
+	past := testNow.Add(-48 * time.Hour)
+	implemented := Implemented{ImplementedAt: testNow}
+	stale := newTestControl(t, "ctrl-1", implemented)
+	fresh := newTestControl(t, "ctrl-2", implemented)
+	inProgress := newTestControl(t, "ctrl-3", InProgress{})
+
+	got := PropagateEvidenceExpiry(
+		[]*Control{stale, fresh, inProgress},
+		map[shared.ControlID][]*Evidence{
+			"ctrl-1": {newTestEvidence(t, "ev-1", "ctrl-1", past, timePtr(testNow.Add(-time.Hour)))},
+			"ctrl-2": {newTestEvidence(t, "ev-2", "ctrl-2", past, timePtr(testNow.Add(time.Hour)))},
+		},
+		testNow,
+	)
+
+	failed, ok := got[0].Status().(Failed)
+	if !ok || failed.Reason != EvidenceExpiredReason || !failed.DetectedAt.Equal(testNow) {
+		t.Errorf("ctrl-1 status = %v, want Failed(%s) at %s", got[0].Status(), EvidenceExpiredReason, testNow)
+	}
+	if got[1] != fresh {
+		t.Error("control with valid evidence was replaced")
+	}
+	if got[2] != inProgress {
+		t.Error("control that is not Implemented was replaced")
+	}
+	if _, ok := stale.Status().(Implemented); !ok {
+		t.Error("PropagateEvidenceExpiry() modified the original control")
+	}
+}
+
+func TestPinnedClockGivesStableStrings(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+	if got, want := r.Status().String(), "Identified (2024-04-01T09:00:00Z)"; got != want {
+		t.Errorf("risk Status().String() = %q, want %q", got, want)
+	}
+
+	lastYear := testNow.AddDate(-1, 0, 0)
+	c, err := newTestControl(t, "ctrl-1").ImplementAsOfAt(testClock, testNow,
+		[]*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", lastYear, timePtr(lastYear.AddDate(0, 6, 0)))})
+	if err != nil {
+		t.Fatalf("ImplementAsOfAt() error = %v", err)
+	}
+	c = PropagateEvidenceExpiry([]*Control{c}, nil, testNow.Add(time.Hour))[0]
+
+	want := []string{
+		"Not Implemented -> Implemented (2024-04-01T09:00:00Z) at 2024-04-01T09:00:00Z",
+		"Implemented (2024-04-01T09:00:00Z) -> Failed: " + EvidenceExpiredReason +
+			" (detected at 2024-04-01T10:00:00Z) at 2024-04-01T10:00:00Z",
+	}
+	var got []string
+	for _, h := range c.History()[1:] { // skip the initial status from creation
+		got = append(got, fmt.Sprintf("%s -> %s at %s", h.From, h.To, h.At.Format(time.RFC3339)))
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("History() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestStatusTransitionsBumpUpdatedAt(t *testing.T) {
+	later := shared.FixedClock{Time: testNow.Add(time.Hour)}
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+
+	type timestamps struct{ created, updated time.Time }
+	transition := map[string]func() (before, after timestamps, err error){
+		"Risk": func() (timestamps, timestamps, error) {
+			r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+			u, err := r.WithStatusAt(later, Assessed{AssessedAt: later.Time, AssessorID: "user-1"})
+			if err != nil {
+				return timestamps{}, timestamps{}, err
+			}
+			return timestamps{r.CreatedAt(), r.UpdatedAt()}, timestamps{u.CreatedAt(), u.UpdatedAt()}, nil
+		},
+		"Control": func() (timestamps, timestamps, error) {
+			c := newTestControl(t, "ctrl-1")
+			u, err := c.WithStatusAt(later, InProgress{Progress: half})
+			if err != nil {
+				return timestamps{}, timestamps{}, err
+			}
+			return timestamps{c.CreatedAt(), c.UpdatedAt()}, timestamps{u.CreatedAt(), u.UpdatedAt()}, nil
+		},
+		"Framework": func() (timestamps, timestamps, error) {
+			f := newTestFramework(t, "fw-1", "ctrl-1")
+			u, err := f.WithStatusAt(later, FrameworkStatusActive)
+			if err != nil {
+				return timestamps{}, timestamps{}, err
+			}
+			return timestamps{f.CreatedAt(), f.UpdatedAt()}, timestamps{u.CreatedAt(), u.UpdatedAt()}, nil
+		},
+	}
+
+	for name, run := range transition {
+		t.Run(name, func(t *testing.T) {
+			before, after, err := run()
+			if err != nil {
+				t.Fatalf("transition error = %v", err)
+			}
+			if !before.created.Equal(testNow) || !before.updated.Equal(testNow) {
+				t.Errorf("before = %+v, want both at %v", before, testNow)
+			}
+			if !after.created.Equal(testNow) {
+				t.Errorf("CreatedAt() after = %v, want unchanged %v", after.created, testNow)
+			}
+			if !after.updated.Equal(later.Time) {
+				t.Errorf("UpdatedAt() after = %v, want %v", after.updated, later.Time)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@
 package domain
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/example/grc-domain-models/domain/shared"
@@ -29,7 +32,7 @@ type InProgress struct {
 
 func (InProgress) controlStatus() {}
 func (s InProgress) String() string {
-	return fmt.Sprintf("In Progress (%d%%)", s.Progress.Value())
+	return fmt.Sprintf("In Progress (%s)", s.Progress)
 }
 
 // Implemented represents a control that has been implemented.
@@ -72,6 +75,29 @@ func MatchControlStatus[T any](
 	onImplemented func(time.Time) T,
 	onNotApplicable func(string) T,
 	onFailed func(string, time.Time) T,
+) T {
+	return MatchControlStatusOr(
+		status,
+		func(s ControlStatus) T {
+			if s == nil {
+				panic("nil ControlStatus passed to MatchControlStatus")
+			}
+			panic(fmt.Sprintf("unknown ControlStatus type: %T", s))
+		},
+		onNotImplemented, onInProgress, onImplemented, onNotApplicable, onFailed,
+	)
+}
+
+// MatchControlStatusOr is like MatchControlStatus but calls fallback instead
+// of panicking when the status is not a known variant.
+func MatchControlStatusOr[T any](
+	status ControlStatus,
+	fallback func(ControlStatus) T,
+	onNotImplemented func() T,
+	onInProgress func(shared.Percentage) T,
+	onImplemented func(time.Time) T,
+	onNotApplicable func(string) T,
+	onFailed func(string, time.Time) T,
 ) T {
 	switch s := status.(type) {
 	case NotImplemented:
@@ -85,20 +111,179 @@ func MatchControlStatus[T any](
 	case Failed:
 		return onFailed(s.Reason, s.DetectedAt)
 	default:
-		panic(fmt.Sprintf("unknown ControlStatus type: %T", status))
+		return fallback(status)
+	}
+}
+
+// IsHealthyControlStatus returns true if the control is Implemented or NotApplicable.
+func IsHealthyControlStatus(s ControlStatus) bool {
+	return MatchControlStatus(
+		s,
+		func() bool { return false },
+		func(shared.Percentage) bool { return false },
+		func(time.Time) bool { return true },
+		func(string) bool { return true },
+		func(string, time.Time) bool { return false },
+	)
+}
+
+// IsFailedControlStatus returns true if the control is Failed.
+func IsFailedControlStatus(s ControlStatus) bool {
+	return MatchControlStatus(
+		s,
+		func() bool { return false },
+		func(shared.Percentage) bool { return false },
+		func(time.Time) bool { return false },
+		func(string) bool { return false },
+		func(string, time.Time) bool { return true },
+	)
+}
+
+// IsTerminalControlStatus returns true if implementation work on the control
+// has concluded, successfully or not: Implemented, NotApplicable or Failed.
+// NotImplemented and InProgress are still in flight.
+func IsTerminalControlStatus(s ControlStatus) bool {
+	return MatchControlStatus(
+		s,
+		func() bool { return false },
+		func(shared.Percentage) bool { return false },
+		func(time.Time) bool { return true },
+		func(string) bool { return true },
+		func(string, time.Time) bool { return true },
+	)
+}
+
+// ControlCompletionPercent returns how complete a control is on a 0-100 scale.
+// counts is false for NotApplicable, which should be left out of averages.
+// A Failed control counts as 0.
+func ControlCompletionPercent(s ControlStatus) (pct int, counts bool) {
+	type completion struct {
+		pct    int
+		counts bool
+	}
+	c := MatchControlStatus(
+		s,
+		func() completion { return completion{0, true} },
+		func(p shared.Percentage) completion { return completion{p.Value(), true} },
+		func(time.Time) completion { return completion{100, true} },
+		func(string) completion { return completion{0, false} },
+		func(string, time.Time) completion { return completion{0, true} },
+	)
+	return c.pct, c.counts
+}
+
+// AverageControlCompletion returns the mean completion percentage of the
+// controls, skipping NotApplicable ones. It returns 0 if no control counts.
+func AverageControlCompletion(controls []*Control) float64 {
+	total, n := 0, 0
+	for _, c := range controls {
+		pct, counts := ControlCompletionPercent(c.status)
+		if !counts {
+			continue
+		}
+		total += pct
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return float64(total) / float64(n)
+}
+
+// ControlStatusEqual returns true if both statuses are the same variant with equal data.
+func ControlStatusEqual(a, b ControlStatus) bool {
+	switch x := a.(type) {
+	case NotImplemented:
+		_, ok := b.(NotImplemented)
+		return ok
+	case InProgress:
+		y, ok := b.(InProgress)
+		return ok && x.Progress.Equal(y.Progress)
+	case Implemented:
+		y, ok := b.(Implemented)
+		return ok && x.ImplementedAt.Equal(y.ImplementedAt)
+	case NotApplicable:
+		y, ok := b.(NotApplicable)
+		return ok && x.Reason == y.Reason
+	case Failed:
+		y, ok := b.(Failed)
+		return ok && x.Reason == y.Reason && x.DetectedAt.Equal(y.DetectedAt)
+	default:
+		return a == nil && b == nil
+	}
+}
+
+// Control status kinds used as states in the control transition table.
+const (
+	ControlStatusNotImplemented = "NotImplemented"
+	ControlStatusInProgress     = "InProgress"
+	ControlStatusImplemented    = "Implemented"
+	ControlStatusNotApplicable  = "NotApplicable"
+	ControlStatusFailed         = "Failed"
+)
+
+// ControlStatusKind returns the kind of a ControlStatus without its data.
+func ControlStatusKind(status ControlStatus) string {
+	return MatchControlStatus(
+		status,
+		func() string { return ControlStatusNotImplemented },
+		func(shared.Percentage) string { return ControlStatusInProgress },
+		func(time.Time) string { return ControlStatusImplemented },
+		func(string) string { return ControlStatusNotApplicable },
+		func(string, time.Time) string { return ControlStatusFailed },
+	)
+}
+
+// controlTransitions allows every transition except Failed to Implemented.
+var controlTransitions = func() *shared.TransitionTable[string] {
+	all := []string{
+		ControlStatusNotImplemented,
+		ControlStatusInProgress,
+		ControlStatusImplemented,
+		ControlStatusNotApplicable,
+		ControlStatusFailed,
 	}
+	t := shared.NewTransitionTable[string]()
+	for _, from := range all {
+		t.Allow(from, all...)
+	}
+	// Business rule: Cannot transition directly from Failed to Implemented
+	return t.Deny(
+		ControlStatusFailed,
+		ControlStatusImplemented,
+		"Cannot transition directly from Failed to Implemented",
+	)
+}()
+
+// AllowedControlTransitions returns the status kinds reachable from the given status.
+func AllowedControlTransitions(from ControlStatus) []string {
+	return controlTransitions.AllowedFrom(ControlStatusKind(from))
+}
+
+// StatusChange records a single control status transition.
+// From is nil for the initial status assigned at creation.
+type StatusChange struct {
+	From ControlStatus
+	To   ControlStatus
+	At   time.Time
 }
 
 // Control represents a compliance control entity.
 // Fields are unexported to ensure immutability.
 type Control struct {
-	id          shared.ControlID
-	frameworkID shared.FrameworkID
-	code        string
-	title       string
-	description string
-	status      ControlStatus
-	ownerID     shared.UserID
+	id            shared.ControlID
+	frameworkID   shared.FrameworkID
+	code          string
+	title         string
+	description   string
+	status        ControlStatus
+	ownerID       shared.UserID
+	frameworkIDs  []shared.FrameworkID
+	statusHistory []StatusChange
+	prerequisites []shared.ControlID
+	clock         shared.Clock // stamps updatedAt; nil means the system clock
+	createdAt     time.Time
+	updatedAt     time.Time
 }
 
 // Getter methods for Control
@@ -109,6 +294,26 @@ func (c *Control) Title() string                 { return c.title }
 func (c *Control) Description() string           { return c.description }
 func (c *Control) Status() ControlStatus         { return c.status }
 func (c *Control) OwnerID() shared.UserID        { return c.ownerID }
+func (c *Control) InFrameworks() []shared.FrameworkID {
+	// Return a copy to maintain immutability
+	result := make([]shared.FrameworkID, len(c.frameworkIDs))
+	copy(result, c.frameworkIDs)
+	return result
+}
+func (c *Control) Prerequisites() []shared.ControlID {
+	// Return a copy to maintain immutability
+	result := make([]shared.ControlID, len(c.prerequisites))
+	copy(result, c.prerequisites)
+	return result
+}
+func (c *Control) History() []StatusChange {
+	// Return a copy to maintain immutability
+	result := make([]StatusChange, len(c.statusHistory))
+	copy(result, c.statusHistory)
+	return result
+}
+func (c *Control) CreatedAt() time.Time { return c.createdAt }
+func (c *Control) UpdatedAt() time.Time { return c.updatedAt }
 
 // CreateControlInput holds the input for creating a Control.
 type CreateControlInput struct {
@@ -122,13 +327,16 @@ type CreateControlInput struct {
 
 // NewControl creates a new Control with validation.
 func NewControl(input CreateControlInput) (*Control, error) {
+	return NewControlAt(shared.SystemClock{}, input)
+}
+
+// NewControlAt creates a new Control, recording its creation time from the given clock.
+func NewControlAt(clock shared.Clock, input CreateControlInput) (*Control, error) {
 	var errors shared.ValidationErrors
 
 	id, err := shared.NewControlID(input.ID)
 	if err != nil {
-		if ve, ok := err.(shared.ValidationError); ok {
-			errors = append(errors, ve)
-		}
+		errors.AddError("id", err)
 	}
 
 	if input.Code == "" {
@@ -143,50 +351,406 @@ func NewControl(input CreateControlInput) (*Control, error) {
 		return nil, errors
 	}
 
+	frameworkIDs := []shared.FrameworkID{}
+	if input.FrameworkID != "" {
+		frameworkIDs = append(frameworkIDs, input.FrameworkID)
+	}
+
+	status := NotImplemented{}
+	now := clock.Now()
+
 	return &Control{
-		id:          id,
-		frameworkID: input.FrameworkID,
-		code:        input.Code,
-		title:       input.Title,
-		description: input.Description,
-		status:      NotImplemented{},
-		ownerID:     input.OwnerID,
+		id:            id,
+		frameworkID:   input.FrameworkID,
+		code:          input.Code,
+		title:         input.Title,
+		description:   input.Description,
+		status:        status,
+		ownerID:       input.OwnerID,
+		frameworkIDs:  frameworkIDs,
+		statusHistory: []StatusChange{{From: nil, To: status, At: now}},
+		clock:         clock,
+		createdAt:     now,
+		updatedAt:     now,
 	}, nil
 }
 
+// Equal returns true if both controls have the same ID and field values,
+// including status, status history, framework membership and prerequisites.
+// Creation and modification timestamps are not compared.
+func (c *Control) Equal(other *Control) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	if len(c.statusHistory) != len(other.statusHistory) {
+		return false
+	}
+	for i, h := range c.statusHistory {
+		o := other.statusHistory[i]
+		if !ControlStatusEqual(h.From, o.From) || !ControlStatusEqual(h.To, o.To) || !h.At.Equal(o.At) {
+			return false
+		}
+	}
+
+	return c.id == other.id &&
+		c.frameworkID == other.frameworkID &&
+		c.code == other.code &&
+		c.title == other.title &&
+		c.description == other.description &&
+		ControlStatusEqual(c.status, other.status) &&
+		c.ownerID == other.ownerID &&
+		slices.Equal(c.frameworkIDs, other.frameworkIDs) &&
+		slices.Equal(c.prerequisites, other.prerequisites)
+}
+
+// Validate re-checks the invariants NewControl enforces. Use it on a Control
+// reconstructed from storage, which bypasses the constructor.
+func (c *Control) Validate() error {
+	var errors shared.ValidationErrors
+
+	if _, err := shared.NewControlID(string(c.id)); err != nil {
+		errors.AddError("id", err)
+	}
+
+	if c.code == "" {
+		errors.Add("code", "Control code is required", "REQUIRED")
+	}
+
+	if c.title == "" {
+		errors.Add("title", "Control title is required", "REQUIRED")
+	}
+
+	if c.status == nil {
+		errors.Add("status", "Control status is required", "REQUIRED")
+	}
+
+	return errors.ToError()
+}
+
+// Clone returns a deep copy of the Control.
+func (c *Control) Clone() *Control {
+	return c.deepCopy()
+}
+
+// clone returns a modified copy of the Control with updatedAt set to now.
+func (c *Control) clone() *Control {
+	copied := c.deepCopy()
+	copied.updatedAt = shared.NowFrom(c.clock)
+	return copied
+}
+
+// deepCopy returns a copy of the Control that shares no mutable state with the original.
+func (c *Control) deepCopy() *Control {
+	copied := *c
+	copied.frameworkIDs = make([]shared.FrameworkID, len(c.frameworkIDs))
+	copy(copied.frameworkIDs, c.frameworkIDs)
+	copied.statusHistory = make([]StatusChange, len(c.statusHistory))
+	copy(copied.statusHistory, c.statusHistory)
+	copied.prerequisites = make([]shared.ControlID, len(c.prerequisites))
+	copy(copied.prerequisites, c.prerequisites)
+	return &copied
+}
+
 // WithStatus returns a new Control with the updated status.
 // This preserves immutability by creating a new instance.
 func (c *Control) WithStatus(newStatus ControlStatus) (*Control, error) {
-	// Business rule: Cannot transition directly from Failed to Implemented
-	if _, isFailed := c.status.(Failed); isFailed {
-		if _, isImplemented := newStatus.(Implemented); isImplemented {
+	return c.WithStatusAt(shared.SystemClock{}, newStatus)
+}
+
+// WithStatusAt is like WithStatus but timestamps the history entry with the given clock.
+// The attempt is reported to the registered TransitionObserver.
+func (c *Control) WithStatusAt(clock shared.Clock, newStatus ControlStatus) (*Control, error) {
+	return c.WithStatusCtx(context.Background(), clock, newStatus)
+}
+
+// WithStatusCtx is like WithStatusAt but reports the attempt to the
+// TransitionObserver carried by ctx, if any (see WithTransitionObserver).
+func (c *Control) WithStatusCtx(ctx context.Context, clock shared.Clock, newStatus ControlStatus) (*Control, error) {
+	updated, err := c.withStatusAt(clock, newStatus)
+	from, to := "", ""
+	if c.status != nil {
+		from = ControlStatusKind(c.status)
+	}
+	if newStatus != nil {
+		to = ControlStatusKind(newStatus)
+	}
+	notifyTransition(ctx, EntityRef{Type: "Control", ID: string(c.id)}, from, to, err)
+	return updated, err
+}
+
+func (c *Control) withStatusAt(clock shared.Clock, newStatus ControlStatus) (*Control, error) {
+	// A nil status on either side has no kind and is not checked against the table
+	if c.status != nil && newStatus != nil {
+		if err := controlTransitions.CanTransition(ControlStatusKind(c.status), ControlStatusKind(newStatus)); err != nil {
+			return nil, err
+		}
+	}
+
+	now := clock.Now()
+	updated := c.clone()
+	updated.status = newStatus
+	updated.statusHistory = append(updated.statusHistory, StatusChange{
+		From: c.status,
+		To:   newStatus,
+		At:   now,
+	})
+	updated.updatedAt = now
+	return updated, nil
+}
+
+// Remediate moves a Failed or NotImplemented control into InProgress with the
+// given progress, the first step of the Failed -> InProgress -> Implemented
+// remediation workflow. An InProgress control has its progress updated.
+// Implemented and NotApplicable controls have nothing to remediate.
+func (c *Control) Remediate(progress shared.Percentage) (*Control, error) {
+	return c.RemediateAt(shared.SystemClock{}, progress)
+}
+
+// RemediateAt is like Remediate but timestamps the history entry with the given clock.
+func (c *Control) RemediateAt(clock shared.Clock, progress shared.Percentage) (*Control, error) {
+	switch c.status.(type) {
+	case Implemented, NotApplicable:
+		return nil, shared.NewValidationError(
+			"status",
+			fmt.Sprintf("Cannot remediate a control that is %s", ControlStatusKind(c.status)),
+			"NOT_REMEDIABLE",
+		)
+	}
+	return c.WithStatusAt(clock, InProgress{Progress: progress})
+}
+
+// ImplementAsOf returns a new Control implemented at a past date.
+// The date must not precede the control's creation or lie in the future,
+// and at least one of the control's evidence must have been collected on
+// or before that date.
+func (c *Control) ImplementAsOf(date time.Time, evidence []*Evidence) (*Control, error) {
+	return c.ImplementAsOfAt(shared.SystemClock{}, date, evidence)
+}
+
+// ImplementAsOfAt is like ImplementAsOf but uses the given clock to reject
+// future dates and to timestamp the history entry.
+func (c *Control) ImplementAsOfAt(clock shared.Clock, date time.Time, evidence []*Evidence) (*Control, error) {
+	var errors shared.ValidationErrors
+
+	// A control reconstructed without a creation time has no lower bound
+	if !c.createdAt.IsZero() && date.Before(c.createdAt) {
+		errors.Add("implementedAt", "Implementation date cannot precede the control's creation", "BACKDATE_TOO_EARLY")
+	}
+
+	if date.After(clock.Now()) {
+		errors.Add("implementedAt", "Implementation date cannot be in the future", "FUTURE_DATE")
+	}
+
+	contemporaneous := false
+	for _, e := range evidence {
+		if e.controlID == c.id && !e.collectedAt.After(date) {
+			contemporaneous = true
+			break
+		}
+	}
+	if !contemporaneous {
+		errors.Add("evidence", "No evidence was collected on or before the implementation date", "NO_CONTEMPORANEOUS_EVIDENCE")
+	}
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	return c.WithStatusAt(clock, Implemented{ImplementedAt: date})
+}
+
+// MinimumTrustMet returns true if at least one of the control's evidence is
+// valid at the given time and has a trust level of at least minLevel.
+func (c *Control) MinimumTrustMet(evidence []*Evidence, minLevel TrustLevel, now time.Time) bool {
+	for _, e := range evidence {
+		if e.controlID == c.id && e.StatusAt(now, 0) == EvidenceStatusValid && e.TrustLevel() >= minLevel {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrerequisite returns a new Control that must be implemented after the given control.
+// Adding an existing prerequisite is a no-op.
+func (c *Control) WithPrerequisite(controlID shared.ControlID) (*Control, error) {
+	if controlID == "" {
+		return nil, shared.NewValidationError("prerequisites", "ControlID cannot be empty", "EMPTY_ID")
+	}
+	if controlID == c.id {
+		return nil, shared.NewValidationError("prerequisites", "A control cannot be its own prerequisite", "PREREQUISITE_CYCLE")
+	}
+	for _, id := range c.prerequisites {
+		if id == controlID {
+			return c, nil
+		}
+	}
+
+	updated := c.clone()
+	updated.prerequisites = append(updated.prerequisites, controlID)
+	return updated, nil
+}
+
+// ImplementationOrder returns the control IDs ordered so that every control
+// comes after its prerequisites. Prerequisites outside the given controls are
+// treated as already satisfied. Among controls whose prerequisites are met,
+// input order is preserved. A cycle is rejected with PREREQUISITE_CYCLE.
+func ImplementationOrder(controls []*Control) ([]shared.ControlID, error) {
+	known := make(map[shared.ControlID]bool, len(controls))
+	for _, c := range controls {
+		known[c.id] = true
+	}
+
+	pending := make(map[shared.ControlID]int, len(controls))
+	dependents := make(map[shared.ControlID][]shared.ControlID)
+	for _, c := range controls {
+		for _, p := range c.prerequisites {
+			if known[p] {
+				pending[c.id]++
+				dependents[p] = append(dependents[p], c.id)
+			}
+		}
+	}
+
+	order := make([]shared.ControlID, 0, len(controls))
+	done := make(map[shared.ControlID]bool, len(controls))
+	for len(order) < len(known) {
+		progressed := false
+		for _, c := range controls {
+			if done[c.id] || pending[c.id] > 0 {
+				continue
+			}
+			done[c.id] = true
+			order = append(order, c.id)
+			for _, d := range dependents[c.id] {
+				pending[d]--
+			}
+			progressed = true
+		}
+		if !progressed {
+			var cyclic []string
+			for _, c := range controls {
+				if !done[c.id] {
+					cyclic = append(cyclic, string(c.id))
+				}
+			}
 			return nil, shared.NewValidationError(
-				"status",
-				"Cannot transition directly from Failed to Implemented",
-				"INVALID_TRANSITION",
+				"prerequisites",
+				fmt.Sprintf("Prerequisite cycle among controls: %s", strings.Join(cyclic, ", ")),
+				"PREREQUISITE_CYCLE",
 			)
 		}
 	}
+	return order, nil
+}
 
-	return &Control{
-		id:          c.id,
-		frameworkID: c.frameworkID,
-		code:        c.code,
-		title:       c.title,
-		description: c.description,
-		status:      newStatus,
-		ownerID:     c.ownerID,
+// BelongsTo returns true if the control is a member of the given framework.
+func (c *Control) BelongsTo(frameworkID shared.FrameworkID) bool {
+	for _, id := range c.frameworkIDs {
+		if id == frameworkID {
+			return true
+		}
+	}
+	return false
+}
+
+// WithOwner returns a new Control owned by newOwner.
+func (c *Control) WithOwner(newOwner shared.UserID) (*Control, error) {
+	if newOwner == "" {
+		return nil, shared.NewValidationError("ownerId", "Control owner is required", "REQUIRED")
+	}
+	updated := c.clone()
+	updated.ownerID = newOwner
+	return updated, nil
+}
+
+// ReassignOwner behaves like WithOwner but also returns an OwnershipChanged
+// event for the audit trail.
+func (c *Control) ReassignOwner(newOwner shared.UserID, at time.Time) (*Control, OwnershipChanged, error) {
+	updated, err := c.WithOwner(newOwner)
+	if err != nil {
+		return nil, OwnershipChanged{}, err
+	}
+	return updated, OwnershipChanged{
+		EntityType: "Control",
+		EntityID:   string(c.id),
+		From:       c.ownerID,
+		To:         newOwner,
+		At:         at,
 	}, nil
 }
 
-// GetControlStatusLabel returns a localized label for the control status.
+// WithFramework returns a new Control that is a member of the given framework.
+// Adding a framework the control already belongs to is a no-op.
+func (c *Control) WithFramework(frameworkID shared.FrameworkID) (*Control, error) {
+	if frameworkID == "" {
+		return nil, shared.NewValidationError("frameworkId", "FrameworkID cannot be empty", "EMPTY_ID")
+	}
+	if c.BelongsTo(frameworkID) {
+		return c, nil
+	}
+
+	updated := c.clone()
+	updated.frameworkIDs = append(updated.frameworkIDs, frameworkID)
+	return updated, nil
+}
+
+// WithoutFramework returns a new Control that is no longer a member of the given framework.
+func (c *Control) WithoutFramework(frameworkID shared.FrameworkID) *Control {
+	if !c.BelongsTo(frameworkID) {
+		return c
+	}
+
+	updated := c.clone()
+	updated.frameworkIDs = updated.frameworkIDs[:0]
+	for _, id := range c.frameworkIDs {
+		if id != frameworkID {
+			updated.frameworkIDs = append(updated.frameworkIDs, id)
+		}
+	}
+	return updated
+}
+
+// LinkControlToFramework adds the control to the framework and records the
+// framework membership on the control, returning both updated entities.
+func LinkControlToFramework(c *Control, f *Framework) (*Control, *Framework, error) {
+	linked, err := c.WithFramework(f.id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return linked, f.WithControl(c.id), nil
+}
+
+// UnlinkControlFromFramework removes the control from the framework and drops
+// the framework membership from the control, returning both updated entities.
+func UnlinkControlFromFramework(c *Control, f *Framework) (*Control, *Framework, error) {
+	unlinked, err := f.WithoutControl(c.id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.WithoutFramework(f.id), unlinked, nil
+}
+
+// GetControlStatusLabel returns a label for the control status in the default language.
 func GetControlStatusLabel(status ControlStatus) string {
+	return GetControlStatusLabelIn(status, shared.DefaultLanguage)
+}
+
+// GetControlStatusLabelIn returns a localized label for the control status
+// using the templates in shared.DefaultCatalog.
+func GetControlStatusLabelIn(status ControlStatus, lang shared.Language) string {
 	return MatchControlStatus(
 		status,
-		func() string { return "未実装" },
-		func(p shared.Percentage) string { return fmt.Sprintf("実装中 (%d%%)", p.Value()) },
-		func(t time.Time) string { return fmt.Sprintf("実装済み (%s)", t.Format(time.RFC3339)) },
-		func(reason string) string { return fmt.Sprintf("適用外: %s", reason) },
-		func(reason string, _ time.Time) string { return fmt.Sprintf("失敗: %s", reason) },
+		func() string { return shared.DefaultCatalog.Format(lang, MsgControlNotImplemented) },
+		func(p shared.Percentage) string {
+			return shared.DefaultCatalog.Format(lang, MsgControlInProgress, p.Value())
+		},
+		func(t time.Time) string {
+			return shared.DefaultCatalog.Format(lang, MsgControlImplemented, t.Format(time.RFC3339))
+		},
+		func(reason string) string { return shared.DefaultCatalog.Format(lang, MsgControlNotApplicable, reason) },
+		func(reason string, _ time.Time) string {
+			return shared.DefaultCatalog.Format(lang, MsgControlFailed, reason)
+		},
 	)
 }
@@ -0,0 +1,378 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestControlFailedToImplementedKeepsMessage(t *testing.T) {
+	c := newTestControl(t, "ctrl-1", Failed{Reason: "audit", DetectedAt: testNow})
+
+	_, err := c.WithStatusAt(testClock, Implemented{ImplementedAt: testNow})
+
+	var ve shared.ValidationError
+	if !errors.As(err, &ve) || ve.Code != "INVALID_TRANSITION" {
+		t.Fatalf("WithStatusAt() error = %v, want INVALID_TRANSITION", err)
+	}
+	if want := "Cannot transition directly from Failed to Implemented"; ve.Message != want {
+		t.Errorf("message = %q, want %q", ve.Message, want)
+	}
+}
+
+func TestStatusOutsideTheTransitionTableIsNotRestricted(t *testing.T) {
+	c, err := newTestControl(t, "ctrl-1").WithStatusAt(testClock, nil)
+	if err != nil {
+		t.Fatalf("WithStatusAt(nil) error = %v", err)
+	}
+	if _, err := c.WithStatusAt(testClock, Implemented{ImplementedAt: testNow}); err != nil {
+		t.Errorf("WithStatusAt(Implemented) from nil error = %v", err)
+	}
+
+	f, err := newTestFramework(t, "fw-1").WithStatusAt(testClock, FrameworkStatus("Archived"))
+	if err != nil {
+		t.Fatalf("WithStatusAt(Archived) error = %v", err)
+	}
+	if _, err := f.WithStatusAt(testClock, FrameworkStatusDraft); err != nil {
+		t.Errorf("WithStatusAt(Draft) from Archived error = %v", err)
+	}
+}
+
+func TestLinkControlToTwoFrameworks(t *testing.T) {
+	c := newTestControl(t, "ctrl-1")
+	soc2 := newTestFramework(t, "fw-soc2")
+	iso := newTestFramework(t, "fw-iso")
+
+	c, soc2, err := LinkControlToFramework(c, soc2)
+	if err != nil {
+		t.Fatalf("LinkControlToFramework(soc2) error = %v", err)
+	}
+	c, iso, err = LinkControlToFramework(c, iso)
+	if err != nil {
+		t.Fatalf("LinkControlToFramework(iso) error = %v", err)
+	}
+	// Linking again is a no-op
+	if c, _, err = LinkControlToFramework(c, soc2); err != nil {
+		t.Fatalf("LinkControlToFramework(soc2) again error = %v", err)
+	}
+
+	if want := []shared.FrameworkID{"fw-soc2", "fw-iso"}; !slices.Equal(c.InFrameworks(), want) {
+		t.Errorf("InFrameworks() = %v, want %v", c.InFrameworks(), want)
+	}
+	if !c.BelongsTo("fw-soc2") || !c.BelongsTo("fw-iso") || c.BelongsTo("fw-hipaa") {
+		t.Error("BelongsTo() does not match the linked frameworks")
+	}
+	for _, f := range []*Framework{soc2, iso} {
+		if !slices.Contains(f.ControlIDs(), c.ID()) {
+			t.Errorf("framework %s does not contain %s", f.ID(), c.ID())
+		}
+	}
+}
+
+func TestControlWithFrameworkRejectsEmptyID(t *testing.T) {
+	_, err := newTestControl(t, "ctrl-1").WithFramework("")
+	if !errors.Is(err, shared.ErrEmptyID) {
+		t.Fatalf("WithFramework(\"\") error = %v, want EMPTY_ID", err)
+	}
+}
+
+func TestControlWithStatusRecordsHistory(t *testing.T) {
+	c := newTestControl(t, "ctrl-1")
+	if len(c.History()) != 1 {
+		t.Fatalf("initial History() has %d entries, want 1", len(c.History()))
+	}
+
+	progress, _ := shared.NewPercentage(40)
+	statuses := []ControlStatus{
+		InProgress{Progress: progress},
+		Implemented{ImplementedAt: testNow},
+		Failed{Reason: "audit", DetectedAt: testNow},
+	}
+	for i, s := range statuses {
+		next, err := c.WithStatusAt(testClock, s)
+		if err != nil {
+			t.Fatalf("WithStatusAt(%s) error = %v", s, err)
+		}
+		if got, want := len(next.History()), len(c.History())+1; got != want {
+			t.Fatalf("step %d: History() has %d entries, want %d", i, got, want)
+		}
+		last := next.History()[len(next.History())-1]
+		if !ControlStatusEqual(last.From, c.Status()) || !ControlStatusEqual(last.To, s) {
+			t.Errorf("step %d: last entry = %v -> %v, want %v -> %v", i, last.From, last.To, c.Status(), s)
+		}
+		c = next
+	}
+
+	history := c.History()
+	history[0] = StatusChange{To: NotApplicable{Reason: "tampered"}}
+	if _, ok := c.History()[0].To.(NotImplemented); !ok {
+		t.Error("mutating the returned history changed the control")
+	}
+}
+
+func TestImplementAsOf(t *testing.T) {
+	created := testNow.Add(-30 * 24 * time.Hour)
+	date := testNow.Add(-7 * 24 * time.Hour)
+	c, err := NewControlAt(shared.FixedClock{Time: created}, CreateControlInput{ID: "ctrl-1", Code: "AC-1", Title: "Access"})
+	if err != nil {
+		t.Fatalf("NewControlAt() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		evidence  []*Evidence
+		wantCodes []string
+	}{
+		{
+			name:     "contemporaneous evidence",
+			date:     date,
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", date.Add(-time.Hour), nil)},
+		},
+		{
+			name:     "evidence collected exactly on the date",
+			date:     date,
+			evidence: []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", date, nil)},
+		},
+		{
+			name:      "evidence collected after the date",
+			date:      date,
+			evidence:  []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", date.Add(time.Hour), nil)},
+			wantCodes: []string{"NO_CONTEMPORANEOUS_EVIDENCE"},
+		},
+		{
+			name:      "evidence for another control",
+			date:      date,
+			evidence:  []*Evidence{newTestEvidence(t, "ev-1", "ctrl-2", date.Add(-time.Hour), nil)},
+			wantCodes: []string{"NO_CONTEMPORANEOUS_EVIDENCE"},
+		},
+		{
+			name:      "before creation",
+			date:      created.Add(-time.Hour),
+			evidence:  []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", created.Add(-2*time.Hour), nil)},
+			wantCodes: []string{"BACKDATE_TOO_EARLY"},
+		},
+		{
+			name:      "in the future",
+			date:      testNow.Add(time.Hour),
+			evidence:  []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", date, nil)},
+			wantCodes: []string{"FUTURE_DATE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ImplementAsOfAt(testClock, tt.date, tt.evidence)
+			if len(tt.wantCodes) > 0 {
+				var errs shared.ValidationErrors
+				if !errors.As(err, &errs) || len(errs) != len(tt.wantCodes) {
+					t.Fatalf("ImplementAsOfAt() error = %v, want %v", err, tt.wantCodes)
+				}
+				for _, code := range tt.wantCodes {
+					if !errs.HasCode(code) {
+						t.Errorf("ImplementAsOfAt() error = %v, want %s", err, code)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImplementAsOfAt() error = %v", err)
+			}
+			implemented, ok := got.Status().(Implemented)
+			if !ok || !implemented.ImplementedAt.Equal(tt.date) {
+				t.Errorf("status = %v, want Implemented at %s", got.Status(), tt.date)
+			}
+		})
+	}
+}
+
+func TestImplementAsOfWithoutCreationTime(t *testing.T) {
+	// A control reconstructed from storage without timestamps or history
+	c := &Control{id: "ctrl-1", code: "AC-1", title: "Access", status: NotImplemented{}}
+	date := testNow.Add(-time.Hour)
+
+	got, err := c.ImplementAsOfAt(testClock, date, []*Evidence{newTestEvidence(t, "ev-1", "ctrl-1", date, nil)})
+	if err != nil {
+		t.Fatalf("ImplementAsOfAt() error = %v", err)
+	}
+	if _, ok := got.Status().(Implemented); !ok {
+		t.Errorf("status = %v, want Implemented", got.Status())
+	}
+}
+
+// withPrerequisites returns c with the given prerequisites, failing the test on error.
+func withPrerequisites(t *testing.T, c *Control, ids ...shared.ControlID) *Control {
+	t.Helper()
+	for _, id := range ids {
+		var err error
+		if c, err = c.WithPrerequisite(id); err != nil {
+			t.Fatalf("WithPrerequisite(%s) error = %v", id, err)
+		}
+	}
+	return c
+}
+
+func TestImplementationOrder(t *testing.T) {
+	// mfa needs sso, which needs directory; logging is independent
+	controls := []*Control{
+		withPrerequisites(t, newTestControl(t, "mfa"), "sso"),
+		newTestControl(t, "logging"),
+		withPrerequisites(t, newTestControl(t, "sso"), "directory", "external"),
+		newTestControl(t, "directory"),
+	}
+
+	got, err := ImplementationOrder(controls)
+	if err != nil {
+		t.Fatalf("ImplementationOrder() error = %v", err)
+	}
+	want := []shared.ControlID{"logging", "directory", "sso", "mfa"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ImplementationOrder() = %v, want %v", got, want)
+	}
+}
+
+func TestImplementationOrderRejectsCycle(t *testing.T) {
+	controls := []*Control{
+		withPrerequisites(t, newTestControl(t, "a"), "b"),
+		withPrerequisites(t, newTestControl(t, "b"), "a"),
+		newTestControl(t, "c"),
+	}
+
+	_, err := ImplementationOrder(controls)
+	if !hasCode(err, "PREREQUISITE_CYCLE") {
+		t.Fatalf("ImplementationOrder() error = %v, want PREREQUISITE_CYCLE", err)
+	}
+	if _, err := newTestControl(t, "a").WithPrerequisite("a"); !hasCode(err, "PREREQUISITE_CYCLE") {
+		t.Errorf("WithPrerequisite(self) error = %v, want PREREQUISITE_CYCLE", err)
+	}
+}
+
+func TestAverageControlCompletionSkipsNotApplicable(t *testing.T) {
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	controls := []*Control{
+		newTestControl(t, "ctrl-1"),
+		newTestControl(t, "ctrl-2", InProgress{Progress: half}),
+		newTestControl(t, "ctrl-3", InProgress{Progress: half}, Implemented{ImplementedAt: testNow}),
+		newTestControl(t, "ctrl-4", NotApplicable{Reason: "no on-prem servers"}),
+	}
+
+	wantPercents := []struct {
+		pct    int
+		counts bool
+	}{{0, true}, {50, true}, {100, true}, {0, false}}
+	for i, c := range controls {
+		pct, counts := ControlCompletionPercent(c.Status())
+		if pct != wantPercents[i].pct || counts != wantPercents[i].counts {
+			t.Errorf("ControlCompletionPercent(%s) = %d, %v, want %d, %v",
+				c.ID(), pct, counts, wantPercents[i].pct, wantPercents[i].counts)
+		}
+	}
+
+	if got := AverageControlCompletion(controls); got != 50 {
+		t.Errorf("AverageControlCompletion() = %v, want 50", got)
+	}
+	if got := AverageControlCompletion(controls[3:]); got != 0 {
+		t.Errorf("AverageControlCompletion(only N/A) = %v, want 0", got)
+	}
+}
+
+func TestControlSlicesAreNotAliased(t *testing.T) {
+	c := withPrerequisites(t, newTestControl(t, "ctrl-1"), "ctrl-0")
+	c, err := c.WithFramework("fw-1")
+	if err != nil {
+		t.Fatalf("WithFramework() error = %v", err)
+	}
+
+	c.Prerequisites()[0] = "mutated"
+	c.InFrameworks()[0] = "mutated"
+
+	if got := c.Prerequisites(); !slices.Equal(got, []shared.ControlID{"ctrl-0"}) {
+		t.Errorf("Prerequisites() = %v, want [ctrl-0]", got)
+	}
+	if got := c.InFrameworks(); !slices.Equal(got, []shared.FrameworkID{"fw-1"}) {
+		t.Errorf("InFrameworks() = %v, want [fw-1]", got)
+	}
+}
+
+func TestControlValidateReconstructed(t *testing.T) {
+	c := newTestControl(t, "ctrl-1").Clone()
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	c.code, c.status = "", nil
+	err := c.Validate()
+	var errs shared.ValidationErrors
+	if !errors.As(err, &errs) || len(errs.FieldErrors("code")) != 1 || len(errs.FieldErrors("status")) != 1 {
+		t.Errorf("Validate() error = %v, want REQUIRED code and status", err)
+	}
+}
+
+func TestControlReassignOwner(t *testing.T) {
+	c := newTestControl(t, "ctrl-1")
+
+	if _, _, err := c.ReassignOwner("", testNow); !errors.Is(err, shared.ErrRequired) {
+		t.Errorf("ReassignOwner(\"\") error = %v, want REQUIRED", err)
+	}
+
+	updated, event, err := c.ReassignOwner("user-2", testNow)
+	if err != nil {
+		t.Fatalf("ReassignOwner() error = %v", err)
+	}
+	if updated.OwnerID() != "user-2" || c.OwnerID() != "user-1" {
+		t.Errorf("OwnerID() = %s (original %s), want user-2 (original user-1)", updated.OwnerID(), c.OwnerID())
+	}
+	want := OwnershipChanged{EntityType: "Control", EntityID: "ctrl-1", From: "user-1", To: "user-2", At: testNow}
+	if event != want {
+		t.Errorf("event = %+v, want %+v", event, want)
+	}
+}
+
+func TestControlRemediateFromEachStatus(t *testing.T) {
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	progress, err := shared.NewPercentage(30)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	inProgress := InProgress{Progress: half}
+
+	tests := []struct {
+		name     string
+		statuses []ControlStatus
+		wantCode string
+	}{
+		{"NotImplemented", nil, ""},
+		{"InProgress", []ControlStatus{inProgress}, ""},
+		{"Failed", []ControlStatus{inProgress, Failed{Reason: "audit finding", DetectedAt: testNow}}, ""},
+		{"Implemented", []ControlStatus{inProgress, Implemented{ImplementedAt: testNow}}, "NOT_REMEDIABLE"},
+		{"NotApplicable", []ControlStatus{NotApplicable{Reason: "out of scope"}}, "NOT_REMEDIABLE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestControl(t, "ctrl-1", tt.statuses...)
+			got, err := c.RemediateAt(testClock, progress)
+			if tt.wantCode != "" {
+				if !hasCode(err, tt.wantCode) {
+					t.Errorf("RemediateAt() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RemediateAt() error = %v", err)
+			}
+			if s, ok := got.Status().(InProgress); !ok || s.Progress != progress {
+				t.Errorf("Status() = %v, want InProgress at 30%%", got.Status())
+			}
+		})
+	}
+}
@@ -0,0 +1,481 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// The DTOs below are the JSON wire format of the entities. Each entity's
+// MarshalJSON encodes its DTO, and the schema package generates the JSON
+// Schema from these types, so the two cannot drift apart.
+//
+// Sealed-interface unions are encoded as an object whose "type" is the
+// variant kind; each union has one DTO struct per variant.
+
+// ControlStatusDTO is the wire form of a ControlStatus.
+type ControlStatusDTO interface{ controlStatusDTO() }
+
+type NotImplementedDTO struct {
+	Type string `json:"type"`
+}
+
+type InProgressDTO struct {
+	Type     string `json:"type"`
+	Progress int    `json:"progress" minimum:"0" maximum:"100"`
+}
+
+type ImplementedDTO struct {
+	Type          string    `json:"type"`
+	ImplementedAt time.Time `json:"implementedAt"`
+}
+
+type NotApplicableDTO struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type FailedDTO struct {
+	Type       string    `json:"type"`
+	Reason     string    `json:"reason"`
+	DetectedAt time.Time `json:"detectedAt"`
+}
+
+func (NotImplementedDTO) controlStatusDTO() {}
+func (InProgressDTO) controlStatusDTO()     {}
+func (ImplementedDTO) controlStatusDTO()    {}
+func (NotApplicableDTO) controlStatusDTO()  {}
+func (FailedDTO) controlStatusDTO()         {}
+
+// RiskStatusDTO is the wire form of a RiskStatus.
+type RiskStatusDTO interface{ riskStatusDTO() }
+
+type IdentifiedDTO struct {
+	Type         string    `json:"type"`
+	IdentifiedAt time.Time `json:"identifiedAt"`
+}
+
+type AssessedDTO struct {
+	Type       string        `json:"type"`
+	AssessedAt time.Time     `json:"assessedAt"`
+	AssessorID shared.UserID `json:"assessorId"`
+}
+
+type MitigatedDTO struct {
+	Type        string             `json:"type"`
+	MitigatedAt time.Time          `json:"mitigatedAt"`
+	ControlIDs  []shared.ControlID `json:"controlIds"`
+}
+
+type AcceptedDTO struct {
+	Type         string        `json:"type"`
+	AcceptedByID shared.UserID `json:"acceptedById"`
+	Reason       string        `json:"reason"`
+	ExpiresAt    time.Time     `json:"expiresAt"`
+}
+
+type ClosedDTO struct {
+	Type       string    `json:"type"`
+	ClosedAt   time.Time `json:"closedAt"`
+	Resolution string    `json:"resolution"`
+	Forced     bool      `json:"forced"`
+}
+
+func (IdentifiedDTO) riskStatusDTO() {}
+func (AssessedDTO) riskStatusDTO()   {}
+func (MitigatedDTO) riskStatusDTO()  {}
+func (AcceptedDTO) riskStatusDTO()   {}
+func (ClosedDTO) riskStatusDTO()     {}
+
+// CheckResultDTO is the wire form of a CheckResult.
+type CheckResultDTO interface{ checkResultDTO() }
+
+type CheckPassedDTO struct {
+	Type string `json:"type"`
+}
+
+// CheckReasonDTO is the wire form of the CheckResult variants that carry a reason.
+type CheckReasonDTO struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+func (CheckPassedDTO) checkResultDTO() {}
+func (CheckReasonDTO) checkResultDTO() {}
+
+// EvidenceTypeDTO is the wire form of an EvidenceType.
+type EvidenceTypeDTO interface{ evidenceTypeDTO() }
+
+type DocumentDTO struct {
+	Type     string   `json:"type"`
+	FileURL  string   `json:"fileUrl" format:"uri"`
+	FileType FileType `json:"fileType"`
+}
+
+type ScreenshotDTO struct {
+	Type       string    `json:"type"`
+	ImageURL   string    `json:"imageUrl" format:"uri"`
+	CapturedAt time.Time `json:"capturedAt"`
+}
+
+type AutomatedCheckDTO struct {
+	Type          string               `json:"type"`
+	IntegrationID shared.IntegrationID `json:"integrationId"`
+	CheckName     string               `json:"checkName"`
+	LastRunAt     time.Time            `json:"lastRunAt"`
+	Result        CheckResultDTO       `json:"result"`
+}
+
+type ManualReviewDTO struct {
+	Type       string        `json:"type"`
+	ReviewerID shared.UserID `json:"reviewerId"`
+	ReviewedAt time.Time     `json:"reviewedAt"`
+	Notes      string        `json:"notes"`
+}
+
+func (DocumentDTO) evidenceTypeDTO()       {}
+func (ScreenshotDTO) evidenceTypeDTO()     {}
+func (AutomatedCheckDTO) evidenceTypeDTO() {}
+func (ManualReviewDTO) evidenceTypeDTO()   {}
+
+// ControlStatusDTOVariants returns one DTO per ControlStatus variant with its
+// Type set, in declaration order. It lets tooling enumerate the union.
+func ControlStatusDTOVariants() []ControlStatusDTO {
+	return []ControlStatusDTO{
+		toControlStatusDTO(NotImplemented{}),
+		toControlStatusDTO(InProgress{}),
+		toControlStatusDTO(Implemented{}),
+		toControlStatusDTO(NotApplicable{}),
+		toControlStatusDTO(Failed{}),
+	}
+}
+
+// RiskStatusDTOVariants returns one DTO per RiskStatus variant with its Type set.
+func RiskStatusDTOVariants() []RiskStatusDTO {
+	return []RiskStatusDTO{
+		toRiskStatusDTO(Identified{}),
+		toRiskStatusDTO(Assessed{}),
+		toRiskStatusDTO(Mitigated{}),
+		toRiskStatusDTO(Accepted{}),
+		toRiskStatusDTO(Closed{}),
+	}
+}
+
+// CheckResultDTOVariants returns one DTO per CheckResult variant with its Type set.
+func CheckResultDTOVariants() []CheckResultDTO {
+	return []CheckResultDTO{
+		toCheckResultDTO(CheckPassed{}),
+		toCheckResultDTO(CheckFailed{}),
+		toCheckResultDTO(CheckSkipped{}),
+		toCheckResultDTO(CheckWarning{}),
+	}
+}
+
+// EvidenceTypeDTOVariants returns one DTO per EvidenceType variant with its Type set.
+func EvidenceTypeDTOVariants() []EvidenceTypeDTO {
+	return []EvidenceTypeDTO{
+		toEvidenceTypeDTO(Document{}),
+		toEvidenceTypeDTO(Screenshot{}),
+		toEvidenceTypeDTO(AutomatedCheck{Result: CheckPassed{}}),
+		toEvidenceTypeDTO(ManualReview{}),
+	}
+}
+
+func toControlStatusDTO(status ControlStatus) ControlStatusDTO {
+	if status == nil {
+		return nil
+	}
+	return MatchControlStatus(
+		status,
+		func() ControlStatusDTO {
+			return NotImplementedDTO{Type: ControlStatusNotImplemented}
+		},
+		func(p shared.Percentage) ControlStatusDTO {
+			return InProgressDTO{Type: ControlStatusInProgress, Progress: p.Value()}
+		},
+		func(at time.Time) ControlStatusDTO {
+			return ImplementedDTO{Type: ControlStatusImplemented, ImplementedAt: at}
+		},
+		func(reason string) ControlStatusDTO {
+			return NotApplicableDTO{Type: ControlStatusNotApplicable, Reason: reason}
+		},
+		func(reason string, at time.Time) ControlStatusDTO {
+			return FailedDTO{Type: ControlStatusFailed, Reason: reason, DetectedAt: at}
+		},
+	)
+}
+
+func toRiskStatusDTO(status RiskStatus) RiskStatusDTO {
+	if status == nil {
+		return nil
+	}
+	return MatchRiskStatus(
+		status,
+		func(at time.Time) RiskStatusDTO {
+			return IdentifiedDTO{Type: RiskStatusIdentified, IdentifiedAt: at}
+		},
+		func(at time.Time, assessorID shared.UserID) RiskStatusDTO {
+			return AssessedDTO{Type: RiskStatusAssessed, AssessedAt: at, AssessorID: assessorID}
+		},
+		func(at time.Time, controlIDs []shared.ControlID) RiskStatusDTO {
+			controlIDs = slices.Clone(controlIDs)
+			if controlIDs == nil {
+				controlIDs = []shared.ControlID{}
+			}
+			return MitigatedDTO{Type: RiskStatusMitigated, MitigatedAt: at, ControlIDs: controlIDs}
+		},
+		func(acceptedByID shared.UserID, reason string, expiresAt time.Time) RiskStatusDTO {
+			return AcceptedDTO{
+				Type:         RiskStatusAccepted,
+				AcceptedByID: acceptedByID,
+				Reason:       reason,
+				ExpiresAt:    expiresAt,
+			}
+		},
+		func(at time.Time, resolution string) RiskStatusDTO {
+			// MatchRiskStatus does not pass Forced; status is a Closed here
+			forced := status.(Closed).Forced
+			return ClosedDTO{Type: RiskStatusClosed, ClosedAt: at, Resolution: resolution, Forced: forced}
+		},
+	)
+}
+
+func toCheckResultDTO(result CheckResult) CheckResultDTO {
+	if result == nil {
+		return nil
+	}
+	return MatchCheckResult(
+		result,
+		func() CheckResultDTO { return CheckPassedDTO{Type: CheckResultPassed} },
+		func(reason string) CheckResultDTO { return CheckReasonDTO{Type: CheckResultFailed, Reason: reason} },
+		func(reason string) CheckResultDTO { return CheckReasonDTO{Type: CheckResultSkipped, Reason: reason} },
+		func(reason string) CheckResultDTO { return CheckReasonDTO{Type: CheckResultWarning, Reason: reason} },
+	)
+}
+
+func toEvidenceTypeDTO(et EvidenceType) EvidenceTypeDTO {
+	if et == nil {
+		return nil
+	}
+	return MatchEvidenceType(
+		et,
+		func(u shared.URL, fileType FileType) EvidenceTypeDTO {
+			return DocumentDTO{Type: EvidenceTypeDocument, FileURL: u.String(), FileType: fileType}
+		},
+		func(u shared.URL, capturedAt time.Time) EvidenceTypeDTO {
+			return ScreenshotDTO{Type: EvidenceTypeScreenshot, ImageURL: u.String(), CapturedAt: capturedAt}
+		},
+		func(integrationID shared.IntegrationID, checkName string, lastRunAt time.Time, result CheckResult) EvidenceTypeDTO {
+			return AutomatedCheckDTO{
+				Type:          EvidenceTypeAutomatedCheck,
+				IntegrationID: integrationID,
+				CheckName:     checkName,
+				LastRunAt:     lastRunAt,
+				Result:        toCheckResultDTO(result),
+			}
+		},
+		func(reviewerID shared.UserID, reviewedAt time.Time, notes string) EvidenceTypeDTO {
+			return ManualReviewDTO{Type: EvidenceTypeManualReview, ReviewerID: reviewerID, ReviewedAt: reviewedAt, Notes: notes}
+		},
+	)
+}
+
+// RiskScoreDTO is the wire form of a RiskScore.
+type RiskScoreDTO struct {
+	Likelihood RiskLevel `json:"likelihood"`
+	Impact     RiskLevel `json:"impact"`
+	Value      int       `json:"value"`
+	Label      string    `json:"label"`
+}
+
+// MoneyDTO is the wire form of a shared.Money.
+type MoneyDTO struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency" pattern:"^[A-Z]{3}$"`
+}
+
+// RiskDTO is the wire form of a Risk.
+type RiskDTO struct {
+	ID             shared.RiskID   `json:"id"`
+	Title          string          `json:"title"`
+	Description    string          `json:"description,omitempty"`
+	Category       RiskCategory    `json:"category,omitempty"`
+	InherentScore  RiskScoreDTO    `json:"inherentScore"`
+	ResidualScore  RiskScoreDTO    `json:"residualScore"`
+	Status         RiskStatusDTO   `json:"status"`
+	OwnerID        shared.UserID   `json:"ownerId,omitempty"`
+	ExpectedLoss   *MoneyDTO       `json:"expectedLoss,omitempty"`
+	StatusSince    time.Time       `json:"statusSince"`
+	RelatedRiskIDs []shared.RiskID `json:"relatedRiskIds,omitempty"`
+	CreatedBy      shared.UserID   `json:"createdBy,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+}
+
+func toRiskScoreDTO(s RiskScore) RiskScoreDTO {
+	return RiskScoreDTO{Likelihood: s.likelihood, Impact: s.impact, Value: s.value, Label: s.label}
+}
+
+// ToDTO returns the wire form of the Risk.
+func (r *Risk) ToDTO() RiskDTO {
+	dto := RiskDTO{
+		ID:             r.id,
+		Title:          r.title,
+		Description:    r.description,
+		Category:       r.category,
+		InherentScore:  toRiskScoreDTO(r.inherentScore),
+		ResidualScore:  toRiskScoreDTO(r.residualScore),
+		Status:         toRiskStatusDTO(r.status),
+		OwnerID:        r.ownerID,
+		StatusSince:    r.statusSince,
+		RelatedRiskIDs: slices.Clone(r.relatedRiskIDs),
+		CreatedBy:      r.createdBy,
+		CreatedAt:      r.createdAt,
+		UpdatedAt:      r.updatedAt,
+	}
+	if r.expectedLoss != nil {
+		dto.ExpectedLoss = &MoneyDTO{Amount: r.expectedLoss.Amount(), Currency: r.expectedLoss.Currency()}
+	}
+	return dto
+}
+
+// MarshalJSON encodes the Risk as its RiskDTO.
+func (r *Risk) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.ToDTO())
+}
+
+// StatusChangeDTO is the wire form of a StatusChange. From is omitted for
+// the initial status.
+type StatusChangeDTO struct {
+	From ControlStatusDTO `json:"from,omitempty"`
+	To   ControlStatusDTO `json:"to"`
+	At   time.Time        `json:"at"`
+}
+
+// ControlDTO is the wire form of a Control.
+type ControlDTO struct {
+	ID            shared.ControlID     `json:"id"`
+	FrameworkID   shared.FrameworkID   `json:"frameworkId"`
+	FrameworkIDs  []shared.FrameworkID `json:"frameworkIds,omitempty"`
+	Code          string               `json:"code"`
+	Title         string               `json:"title"`
+	Description   string               `json:"description,omitempty"`
+	Status        ControlStatusDTO     `json:"status"`
+	OwnerID       shared.UserID        `json:"ownerId,omitempty"`
+	Prerequisites []shared.ControlID   `json:"prerequisites,omitempty"`
+	History       []StatusChangeDTO    `json:"history,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+}
+
+// ToDTO returns the wire form of the Control.
+func (c *Control) ToDTO() ControlDTO {
+	var history []StatusChangeDTO
+	for _, change := range c.statusHistory {
+		history = append(history, StatusChangeDTO{
+			From: toControlStatusDTO(change.From),
+			To:   toControlStatusDTO(change.To),
+			At:   change.At,
+		})
+	}
+	return ControlDTO{
+		ID:            c.id,
+		FrameworkID:   c.frameworkID,
+		FrameworkIDs:  slices.Clone(c.frameworkIDs),
+		Code:          c.code,
+		Title:         c.title,
+		Description:   c.description,
+		Status:        toControlStatusDTO(c.status),
+		OwnerID:       c.ownerID,
+		Prerequisites: slices.Clone(c.prerequisites),
+		History:       history,
+		CreatedAt:     c.createdAt,
+		UpdatedAt:     c.updatedAt,
+	}
+}
+
+// MarshalJSON encodes the Control as its ControlDTO.
+func (c *Control) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.ToDTO())
+}
+
+// EvidenceDTO is the wire form of an Evidence. TrustLevel is present only
+// when overridden (see WithTrustLevel).
+type EvidenceDTO struct {
+	ID           shared.EvidenceID  `json:"id"`
+	ControlID    shared.ControlID   `json:"controlId"`
+	EvidenceType EvidenceTypeDTO    `json:"evidenceType"`
+	CollectedAt  time.Time          `json:"collectedAt"`
+	ExpiresAt    *time.Time         `json:"expiresAt,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	TrustLevel   *TrustLevel        `json:"trustLevel,omitempty"`
+	SupersedesID *shared.EvidenceID `json:"supersedesId,omitempty"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+}
+
+// ToDTO returns the wire form of the Evidence.
+func (e *Evidence) ToDTO() EvidenceDTO {
+	dto := EvidenceDTO{
+		ID:           e.id,
+		ControlID:    e.controlID,
+		EvidenceType: toEvidenceTypeDTO(e.evidenceType),
+		CollectedAt:  e.collectedAt,
+		ExpiresAt:    cloneTime(e.expiresAt),
+		Description:  e.description,
+		SupersedesID: cloneEvidenceID(e.supersedesID),
+		CreatedAt:    e.createdAt,
+		UpdatedAt:    e.updatedAt,
+	}
+	if e.trustLevel != nil {
+		level := *e.trustLevel
+		dto.TrustLevel = &level
+	}
+	return dto
+}
+
+// MarshalJSON encodes the Evidence as its EvidenceDTO.
+func (e *Evidence) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.ToDTO())
+}
+
+// FrameworkDTO is the wire form of a Framework.
+type FrameworkDTO struct {
+	ID           shared.FrameworkID `json:"id"`
+	Type         FrameworkType      `json:"type"`
+	Name         string             `json:"name"`
+	Version      string             `json:"version" pattern:"^\\d+\\.\\d+(\\.\\d+)?$"`
+	Description  string             `json:"description,omitempty"`
+	Status       FrameworkStatus    `json:"status"`
+	ControlIDs   []shared.ControlID `json:"controlIds"`
+	DeprecatedAt *time.Time         `json:"deprecatedAt,omitempty"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+}
+
+// ToDTO returns the wire form of the Framework.
+func (f *Framework) ToDTO() FrameworkDTO {
+	controlIDs := slices.Clone(f.controlIDs)
+	if controlIDs == nil {
+		controlIDs = []shared.ControlID{}
+	}
+	return FrameworkDTO{
+		ID:           f.id,
+		Type:         f.fwType,
+		Name:         f.name,
+		Version:      f.version,
+		Description:  f.description,
+		Status:       f.status,
+		ControlIDs:   controlIDs,
+		DeprecatedAt: cloneTime(f.deprecatedAt),
+		CreatedAt:    f.createdAt,
+		UpdatedAt:    f.updatedAt,
+	}
+}
+
+// MarshalJSON encodes the Framework as its FrameworkDTO.
+func (f *Framework) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.ToDTO())
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// DomainEvent describes a state change of an entity.
+// Uses the sealed interface pattern.
+type DomainEvent interface {
+	domainEvent()
+	OccurredAt() time.Time
+	String() string
+}
+
+// DomainEvents accumulates events emitted by a sequence of operations.
+type DomainEvents []DomainEvent
+
+// Record appends an event to the collection.
+func (e *DomainEvents) Record(event DomainEvent) {
+	*e = append(*e, event)
+}
+
+// RiskStatusChanged is emitted when a Risk transitions to a new status.
+type RiskStatusChanged struct {
+	RiskID shared.RiskID
+	From   RiskStatus
+	To     RiskStatus
+	At     time.Time
+}
+
+func (RiskStatusChanged) domainEvent()            {}
+func (e RiskStatusChanged) OccurredAt() time.Time { return e.At }
+func (e RiskStatusChanged) String() string {
+	return fmt.Sprintf("Risk %s: %s -> %s", e.RiskID, e.From.String(), e.To.String())
+}
+
+// OwnershipChanged is emitted when a Control or Risk is reassigned to a new owner.
+type OwnershipChanged struct {
+	EntityType string // "Control" or "Risk"
+	EntityID   string
+	From       shared.UserID
+	To         shared.UserID
+	At         time.Time
+}
+
+func (OwnershipChanged) domainEvent()            {}
+func (e OwnershipChanged) OccurredAt() time.Time { return e.At }
+func (e OwnershipChanged) String() string {
+	return fmt.Sprintf("%s %s: owner %s -> %s", e.EntityType, e.EntityID, e.From, e.To)
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/example/grc-domain-models/domain/shared"
@@ -43,6 +45,60 @@ type CheckSkipped struct {
 func (CheckSkipped) checkResult()     {}
 func (c CheckSkipped) String() string { return fmt.Sprintf("Skipped: %s", c.Reason) }
 
+// CheckWarning is a passing check that surfaced a concern,
+// e.g. a certificate expiring soon.
+type CheckWarning struct {
+	Reason string
+}
+
+func (CheckWarning) checkResult()     {}
+func (c CheckWarning) String() string { return fmt.Sprintf("Warning: %s", c.Reason) }
+
+// Check result kinds, as used in the "type" of a CheckResultDTO.
+const (
+	CheckResultPassed  = "Passed"
+	CheckResultFailed  = "Failed"
+	CheckResultSkipped = "Skipped"
+	CheckResultWarning = "Warning"
+)
+
+// MatchCheckResult provides pattern matching for CheckResult.
+func MatchCheckResult[T any](
+	r CheckResult,
+	onPassed func() T,
+	onFailed func(reason string) T,
+	onSkipped func(reason string) T,
+	onWarning func(reason string) T,
+) T {
+	if r == nil {
+		panic("nil CheckResult passed to MatchCheckResult")
+	}
+	switch c := r.(type) {
+	case CheckPassed:
+		return onPassed()
+	case CheckFailed:
+		return onFailed(c.Reason)
+	case CheckSkipped:
+		return onSkipped(c.Reason)
+	case CheckWarning:
+		return onWarning(c.Reason)
+	default:
+		panic(fmt.Sprintf("unknown CheckResult: %T", r))
+	}
+}
+
+// IsCheckActionable returns true if the result needs someone to act on it:
+// a failure or a warning.
+func IsCheckActionable(r CheckResult) bool {
+	return MatchCheckResult(
+		r,
+		func() bool { return false },
+		func(string) bool { return true },
+		func(string) bool { return false },
+		func(string) bool { return true },
+	)
+}
+
 // EvidenceType represents the type of evidence.
 // Uses the sealed interface pattern.
 type EvidenceType interface {
@@ -55,6 +111,18 @@ type Document struct {
 	FileType FileType
 }
 
+// webSchemes are the URL schemes allowed for evidence rendered in a browser.
+var webSchemes = []string{"https", "http"}
+
+// NewDocument creates a Document evidence type whose URL must be http or https.
+func NewDocument(fileURL string, fileType FileType) (Document, error) {
+	u, err := shared.NewURLWithSchemes(fileURL, webSchemes...)
+	if err != nil {
+		return Document{}, err
+	}
+	return Document{FileURL: u, FileType: fileType}, nil
+}
+
 func (Document) evidenceType() {}
 func (d Document) String() string {
 	return fmt.Sprintf("Document (%s)", d.FileType)
@@ -65,6 +133,15 @@ type Screenshot struct {
 	CapturedAt time.Time
 }
 
+// NewScreenshot creates a Screenshot evidence type whose URL must be http or https.
+func NewScreenshot(imageURL string, capturedAt time.Time) (Screenshot, error) {
+	u, err := shared.NewURLWithSchemes(imageURL, webSchemes...)
+	if err != nil {
+		return Screenshot{}, err
+	}
+	return Screenshot{ImageURL: u, CapturedAt: capturedAt}, nil
+}
+
 func (Screenshot) evidenceType() {}
 func (s Screenshot) String() string {
 	return fmt.Sprintf("Screenshot (captured at %s)", s.CapturedAt.Format(time.RFC3339))
@@ -82,6 +159,26 @@ func (a AutomatedCheck) String() string {
 	return fmt.Sprintf("Automated Check: %s (%s)", a.CheckName, a.Result.String())
 }
 
+// DefaultAutomatedCheckMaxAge is how long an automated check result is
+// trusted without a new run. Status and StatusAt report older checks as
+// Stale; use StatusWithMaxAge for a different limit.
+const DefaultAutomatedCheckMaxAge = 30 * 24 * time.Hour
+
+// Age returns how long ago the check last ran.
+func (a AutomatedCheck) Age(now time.Time) time.Duration {
+	return now.Sub(a.LastRunAt)
+}
+
+// IsStale returns true if the check has not run for longer than maxAge.
+// A check exactly maxAge old is not stale; a non-positive maxAge disables the check.
+// A check with a zero LastRunAt has never run and is always stale.
+func (a AutomatedCheck) IsStale(now time.Time, maxAge time.Duration) bool {
+	if maxAge <= 0 {
+		return false
+	}
+	return a.LastRunAt.IsZero() || a.Age(now) > maxAge
+}
+
 type ManualReview struct {
 	ReviewerID shared.UserID
 	ReviewedAt time.Time
@@ -100,6 +197,28 @@ func MatchEvidenceType[T any](
 	onScreenshot func(shared.URL, time.Time) T,
 	onAutomatedCheck func(shared.IntegrationID, string, time.Time, CheckResult) T,
 	onManualReview func(shared.UserID, time.Time, string) T,
+) T {
+	return MatchEvidenceTypeOr(
+		et,
+		func(e EvidenceType) T {
+			if e == nil {
+				panic("nil EvidenceType passed to MatchEvidenceType")
+			}
+			panic(fmt.Sprintf("unknown EvidenceType: %T", e))
+		},
+		onDocument, onScreenshot, onAutomatedCheck, onManualReview,
+	)
+}
+
+// MatchEvidenceTypeOr is like MatchEvidenceType but calls fallback instead
+// of panicking when the type is not a known variant.
+func MatchEvidenceTypeOr[T any](
+	et EvidenceType,
+	fallback func(EvidenceType) T,
+	onDocument func(shared.URL, FileType) T,
+	onScreenshot func(shared.URL, time.Time) T,
+	onAutomatedCheck func(shared.IntegrationID, string, time.Time, CheckResult) T,
+	onManualReview func(shared.UserID, time.Time, string) T,
 ) T {
 	switch e := et.(type) {
 	case Document:
@@ -111,7 +230,128 @@ func MatchEvidenceType[T any](
 	case ManualReview:
 		return onManualReview(e.ReviewerID, e.ReviewedAt, e.Notes)
 	default:
-		panic(fmt.Sprintf("unknown EvidenceType: %T", et))
+		return fallback(et)
+	}
+}
+
+// TrustLevel represents how reliable the source of evidence is.
+// Higher levels are more trustworthy.
+type TrustLevel int
+
+const (
+	TrustLevelUntrusted TrustLevel = iota + 1
+	TrustLevelSelfAttested
+	TrustLevelSystemVerified
+)
+
+func (t TrustLevel) String() string {
+	switch t {
+	case TrustLevelUntrusted:
+		return "Untrusted"
+	case TrustLevelSelfAttested:
+		return "SelfAttested"
+	case TrustLevelSystemVerified:
+		return "SystemVerified"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsValid returns true if the level is between TrustLevelUntrusted and
+// TrustLevelSystemVerified.
+func (t TrustLevel) IsValid() bool {
+	return t >= TrustLevelUntrusted && t <= TrustLevelSystemVerified
+}
+
+// DefaultTrustLevel derives the trust level of an evidence type.
+func DefaultTrustLevel(et EvidenceType) TrustLevel {
+	return MatchEvidenceType(
+		et,
+		func(shared.URL, FileType) TrustLevel { return TrustLevelUntrusted },
+		func(shared.URL, time.Time) TrustLevel { return TrustLevelUntrusted },
+		func(shared.IntegrationID, string, time.Time, CheckResult) TrustLevel { return TrustLevelSystemVerified },
+		func(shared.UserID, time.Time, string) TrustLevel { return TrustLevelSelfAttested },
+	)
+}
+
+// IsAutomatedEvidence returns true if the evidence was produced by an automated check.
+func IsAutomatedEvidence(et EvidenceType) bool {
+	return MatchEvidenceType(
+		et,
+		func(shared.URL, FileType) bool { return false },
+		func(shared.URL, time.Time) bool { return false },
+		func(shared.IntegrationID, string, time.Time, CheckResult) bool { return true },
+		func(shared.UserID, time.Time, string) bool { return false },
+	)
+}
+
+// RequiresHumanReview returns true for evidence that a person must inspect:
+// manual reviews and screenshots.
+func RequiresHumanReview(et EvidenceType) bool {
+	return MatchEvidenceType(
+		et,
+		func(shared.URL, FileType) bool { return false },
+		func(shared.URL, time.Time) bool { return true },
+		func(shared.IntegrationID, string, time.Time, CheckResult) bool { return false },
+		func(shared.UserID, time.Time, string) bool { return true },
+	)
+}
+
+// EvidenceSourceKind classifies how the evidence was obtained:
+// "automated" for automated checks, "captured" for screenshots,
+// and "manual" for documents and manual reviews.
+func EvidenceSourceKind(et EvidenceType) string {
+	return MatchEvidenceType(
+		et,
+		func(shared.URL, FileType) string { return "manual" },
+		func(shared.URL, time.Time) string { return "captured" },
+		func(shared.IntegrationID, string, time.Time, CheckResult) string { return "automated" },
+		func(shared.UserID, time.Time, string) string { return "manual" },
+	)
+}
+
+// Evidence type kinds, as returned by EvidenceTypeKind.
+const (
+	EvidenceTypeDocument       = "Document"
+	EvidenceTypeScreenshot     = "Screenshot"
+	EvidenceTypeAutomatedCheck = "AutomatedCheck"
+	EvidenceTypeManualReview   = "ManualReview"
+)
+
+// EvidenceTypeKind returns the variant name of the evidence type.
+func EvidenceTypeKind(et EvidenceType) string {
+	return MatchEvidenceType(
+		et,
+		func(shared.URL, FileType) string { return EvidenceTypeDocument },
+		func(shared.URL, time.Time) string { return EvidenceTypeScreenshot },
+		func(shared.IntegrationID, string, time.Time, CheckResult) string { return EvidenceTypeAutomatedCheck },
+		func(shared.UserID, time.Time, string) string { return EvidenceTypeManualReview },
+	)
+}
+
+// CheckResultEqual returns true if both results are the same variant with equal data.
+func CheckResultEqual(a, b CheckResult) bool {
+	return a == b
+}
+
+// EvidenceTypeEqual returns true if both evidence types are the same variant with equal data.
+func EvidenceTypeEqual(a, b EvidenceType) bool {
+	switch x := a.(type) {
+	case Document:
+		y, ok := b.(Document)
+		return ok && x.FileURL.Equal(y.FileURL) && x.FileType == y.FileType
+	case Screenshot:
+		y, ok := b.(Screenshot)
+		return ok && x.ImageURL.Equal(y.ImageURL) && x.CapturedAt.Equal(y.CapturedAt)
+	case AutomatedCheck:
+		y, ok := b.(AutomatedCheck)
+		return ok && x.IntegrationID == y.IntegrationID && x.CheckName == y.CheckName &&
+			x.LastRunAt.Equal(y.LastRunAt) && CheckResultEqual(x.Result, y.Result)
+	case ManualReview:
+		y, ok := b.(ManualReview)
+		return ok && x.ReviewerID == y.ReviewerID && x.ReviewedAt.Equal(y.ReviewedAt) && x.Notes == y.Notes
+	default:
+		return a == nil && b == nil
 	}
 }
 
@@ -119,10 +359,12 @@ func MatchEvidenceType[T any](
 type EvidenceStatus string
 
 const (
-	EvidenceStatusValid    EvidenceStatus = "Valid"
-	EvidenceStatusExpired  EvidenceStatus = "Expired"
-	EvidenceStatusPending  EvidenceStatus = "Pending"
-	EvidenceStatusRejected EvidenceStatus = "Rejected"
+	EvidenceStatusValid        EvidenceStatus = "Valid"
+	EvidenceStatusExpiringSoon EvidenceStatus = "ExpiringSoon"
+	EvidenceStatusExpired      EvidenceStatus = "Expired"
+	EvidenceStatusPending      EvidenceStatus = "Pending"
+	EvidenceStatusRejected     EvidenceStatus = "Rejected"
+	EvidenceStatusStale        EvidenceStatus = "Stale"
 )
 
 // Evidence represents a piece of compliance evidence.
@@ -133,6 +375,11 @@ type Evidence struct {
 	collectedAt  time.Time
 	expiresAt    *time.Time // nil means no expiration
 	description  string
+	trustLevel   *TrustLevel        // nil means derived from the evidence type
+	supersedesID *shared.EvidenceID // nil unless this evidence replaces an earlier piece
+	clock        shared.Clock       // stamps updatedAt; nil means the system clock
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
 // Getter methods
@@ -140,8 +387,13 @@ func (e *Evidence) ID() shared.EvidenceID      { return e.id }
 func (e *Evidence) ControlID() shared.ControlID { return e.controlID }
 func (e *Evidence) EvidenceType() EvidenceType  { return e.evidenceType }
 func (e *Evidence) CollectedAt() time.Time      { return e.collectedAt }
-func (e *Evidence) ExpiresAt() *time.Time       { return e.expiresAt }
+func (e *Evidence) ExpiresAt() *time.Time       { return cloneTime(e.expiresAt) }
 func (e *Evidence) Description() string         { return e.description }
+func (e *Evidence) SupersedesID() *shared.EvidenceID {
+	return cloneEvidenceID(e.supersedesID)
+}
+func (e *Evidence) CreatedAt() time.Time { return e.createdAt }
+func (e *Evidence) UpdatedAt() time.Time { return e.updatedAt }
 
 // CreateEvidenceInput holds the input for creating Evidence.
 type CreateEvidenceInput struct {
@@ -151,20 +403,24 @@ type CreateEvidenceInput struct {
 	CollectedAt  time.Time
 	ExpiresAt    *time.Time
 	Description  string
+	SupersedesID *shared.EvidenceID // optional earlier evidence this replaces
 }
 
 // NewEvidence creates a new Evidence with validation.
 func NewEvidence(input CreateEvidenceInput) (*Evidence, error) {
+	return NewEvidenceAt(shared.SystemClock{}, input)
+}
+
+// NewEvidenceAt creates a new Evidence, validating dates against the given clock.
+func NewEvidenceAt(clock shared.Clock, input CreateEvidenceInput) (*Evidence, error) {
 	var errors shared.ValidationErrors
 
 	id, err := shared.NewEvidenceID(input.ID)
 	if err != nil {
-		if ve, ok := err.(shared.ValidationError); ok {
-			errors = append(errors, ve)
-		}
+		errors.AddError("id", err)
 	}
 
-	now := time.Now()
+	now := clock.Now()
 
 	// Validate expiration date
 	if input.ExpiresAt != nil && input.ExpiresAt.Before(now) {
@@ -176,6 +432,11 @@ func NewEvidence(input CreateEvidenceInput) (*Evidence, error) {
 		errors.Add("collectedAt", "Collection date cannot be in the future", "INVALID_COLLECTION_DATE")
 	}
 
+	// Validate expiration relative to collection
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(input.CollectedAt) {
+		errors.Add("expiresAt", "Expiration date must be after the collection date", "EXPIRES_BEFORE_COLLECTION")
+	}
+
 	if errors.HasErrors() {
 		return nil, errors
 	}
@@ -185,15 +446,174 @@ func NewEvidence(input CreateEvidenceInput) (*Evidence, error) {
 		controlID:    input.ControlID,
 		evidenceType: input.EvidenceType,
 		collectedAt:  input.CollectedAt,
-		expiresAt:    input.ExpiresAt,
+		expiresAt:    cloneTime(input.ExpiresAt),
 		description:  input.Description,
+		supersedesID: cloneEvidenceID(input.SupersedesID),
+		clock:        clock,
+		createdAt:    now,
+		updatedAt:    now,
 	}, nil
 }
 
+// TrustLevel returns the overridden trust level, or the level derived from the evidence type.
+func (e *Evidence) TrustLevel() TrustLevel {
+	if e.trustLevel != nil {
+		return *e.trustLevel
+	}
+	return DefaultTrustLevel(e.evidenceType)
+}
+
+// WithTrustLevel returns a new Evidence with the trust level overridden.
+func (e *Evidence) WithTrustLevel(level TrustLevel) (*Evidence, error) {
+	if !level.IsValid() {
+		return nil, shared.NewValidationError(
+			"trustLevel",
+			fmt.Sprintf("Trust level must be between %s and %s", TrustLevelUntrusted, TrustLevelSystemVerified),
+			"INVALID_TRUST_LEVEL",
+		)
+	}
+
+	updated := e.clone()
+	updated.trustLevel = &level
+	return updated, nil
+}
+
+// Equal returns true if both evidence have the same ID and field values.
+// Creation and modification timestamps are not compared.
+func (e *Evidence) Equal(other *Evidence) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	sameExpiry := (e.expiresAt == nil) == (other.expiresAt == nil)
+	if sameExpiry && e.expiresAt != nil {
+		sameExpiry = e.expiresAt.Equal(*other.expiresAt)
+	}
+
+	return e.id == other.id &&
+		e.controlID == other.controlID &&
+		EvidenceTypeEqual(e.evidenceType, other.evidenceType) &&
+		e.collectedAt.Equal(other.collectedAt) &&
+		sameExpiry &&
+		e.description == other.description &&
+		e.TrustLevel() == other.TrustLevel() &&
+		(e.supersedesID == nil) == (other.supersedesID == nil) &&
+		(e.supersedesID == nil || *e.supersedesID == *other.supersedesID)
+}
+
+// Validate re-checks the invariants NewEvidence enforces that do not depend
+// on the current time. Use it on Evidence reconstructed from storage, which
+// bypasses the constructor and may legitimately have expired since.
+func (e *Evidence) Validate() error {
+	var errors shared.ValidationErrors
+
+	if _, err := shared.NewEvidenceID(string(e.id)); err != nil {
+		errors.AddError("id", err)
+	}
+
+	if e.evidenceType == nil {
+		errors.Add("evidenceType", "Evidence type is required", "REQUIRED")
+	}
+
+	if e.expiresAt != nil && !e.expiresAt.After(e.collectedAt) {
+		errors.Add("expiresAt", "Expiration date must be after the collection date", "EXPIRES_BEFORE_COLLECTION")
+	}
+
+	return errors.ToError()
+}
+
+// Clone returns a deep copy of the Evidence.
+func (e *Evidence) Clone() *Evidence {
+	return e.deepCopy()
+}
+
+// clone returns a modified copy of the Evidence with updatedAt set to now.
+func (e *Evidence) clone() *Evidence {
+	copied := e.deepCopy()
+	copied.updatedAt = shared.NowFrom(e.clock)
+	return copied
+}
+
+// deepCopy returns a copy of the Evidence that shares no mutable state with the original.
+func (e *Evidence) deepCopy() *Evidence {
+	copied := *e
+	copied.expiresAt = cloneTime(e.expiresAt)
+	copied.supersedesID = cloneEvidenceID(e.supersedesID)
+	return &copied
+}
+
+// Supersedes returns a new Evidence linked to the older evidence it replaces.
+// Both must be for the same control and e must have been collected after old.
+func (e *Evidence) Supersedes(old *Evidence) (*Evidence, error) {
+	var errors shared.ValidationErrors
+
+	if e.controlID != old.controlID {
+		errors.Add(
+			"controlId",
+			fmt.Sprintf("Evidence %s is for control %s, not %s", old.id, old.controlID, e.controlID),
+			"EVIDENCE_CONTROL_MISMATCH",
+		)
+	}
+
+	if !e.collectedAt.After(old.collectedAt) {
+		errors.Add(
+			"collectedAt",
+			fmt.Sprintf("Evidence must be collected after the evidence it supersedes (%s)", old.id),
+			"INVALID_SUPERSESSION",
+		)
+	}
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	updated := e.clone()
+	oldID := old.id
+	updated.supersedesID = &oldID
+	return updated, nil
+}
+
+// cloneEvidenceID copies an optional evidence ID so the pointer is never shared.
+func cloneEvidenceID(id *shared.EvidenceID) *shared.EvidenceID {
+	if id == nil {
+		return nil
+	}
+	copied := *id
+	return &copied
+}
+
+// cloneTime copies an optional timestamp so the pointer is never shared.
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
 // Status calculates the current status of the evidence.
 func (e *Evidence) Status() EvidenceStatus {
-	now := time.Now()
+	return e.StatusAt(time.Now(), 0)
+}
 
+// StatusAt calculates the status of the evidence at the given time.
+// Evidence that would otherwise be Valid but expires within warnWindow is
+// reported as ExpiringSoon; a zero window disables the warning.
+// An automated check that has not run within DefaultAutomatedCheckMaxAge is
+// reported as Stale, since its last result can no longer be relied on.
+func (e *Evidence) StatusAt(now time.Time, warnWindow time.Duration) EvidenceStatus {
+	return e.status(now, warnWindow, DefaultAutomatedCheckMaxAge)
+}
+
+// StatusWithMaxAge is like StatusAt without a warning window, but uses maxAge
+// instead of DefaultAutomatedCheckMaxAge to decide whether an automated check
+// is Stale. A check that has never run (zero LastRunAt) is Stale for any
+// positive maxAge; a non-positive maxAge disables the staleness check.
+func (e *Evidence) StatusWithMaxAge(now time.Time, maxAge time.Duration) EvidenceStatus {
+	return e.status(now, 0, maxAge)
+}
+
+func (e *Evidence) status(now time.Time, warnWindow, maxAge time.Duration) EvidenceStatus {
 	// Check expiration
 	if e.expiresAt != nil && e.expiresAt.Before(now) {
 		return EvidenceStatusExpired
@@ -206,25 +626,201 @@ func (e *Evidence) Status() EvidenceStatus {
 			return EvidenceStatusRejected
 		case CheckSkipped:
 			return EvidenceStatusPending
+		case CheckWarning:
+			// A warning does not invalidate the evidence; see Warning
+		}
+		if ac.IsStale(now, maxAge) {
+			return EvidenceStatusStale
 		}
 	}
 
+	if warnWindow > 0 && e.expiresWithinAt(now, warnWindow) {
+		return EvidenceStatusExpiringSoon
+	}
+
 	return EvidenceStatusValid
 }
 
-// GetEvidenceTypeLabel returns a localized label for the evidence type.
+// Warning returns the reason of an automated check warning, if any.
+func (e *Evidence) Warning() (string, bool) {
+	if ac, ok := e.evidenceType.(AutomatedCheck); ok {
+		if w, ok := ac.Result.(CheckWarning); ok {
+			return w.Reason, true
+		}
+	}
+	return "", false
+}
+
+// ExpiresWithin returns true if the evidence has not yet expired but will
+// expire within the given duration from now.
+func (e *Evidence) ExpiresWithin(d time.Duration) bool {
+	return e.expiresWithinAt(time.Now(), d)
+}
+
+func (e *Evidence) expiresWithinAt(now time.Time, d time.Duration) bool {
+	if e.expiresAt == nil || e.expiresAt.Before(now) {
+		return false
+	}
+	return !e.expiresAt.After(now.Add(d))
+}
+
+// WithEvidenceType returns a new Evidence with the given evidence type.
+func (e *Evidence) WithEvidenceType(et EvidenceType) (*Evidence, error) {
+	var errors shared.ValidationErrors
+	validateEvidenceType(&errors, et)
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	updated := e.clone()
+	updated.evidenceType = et
+	return updated, nil
+}
+
+// validateEvidenceType appends an error if et is nil, not one of the known
+// variants, or an automated check without a result.
+func validateEvidenceType(errors *shared.ValidationErrors, et EvidenceType) {
+	if et == nil {
+		errors.Add("evidenceType", "Evidence type is required", "REQUIRED")
+		return
+	}
+	MatchEvidenceTypeOr(
+		et,
+		func(et EvidenceType) struct{} {
+			errors.Add("evidenceType", fmt.Sprintf("Unknown evidence type: %T", et), "INVALID_EVIDENCE_TYPE")
+			return struct{}{}
+		},
+		func(shared.URL, FileType) struct{} { return struct{}{} },
+		func(shared.URL, time.Time) struct{} { return struct{}{} },
+		func(_ shared.IntegrationID, _ string, _ time.Time, result CheckResult) struct{} {
+			if result == nil {
+				errors.Add("evidenceType.result", "Automated check result is required", "REQUIRED")
+			}
+			return struct{}{}
+		},
+		func(shared.UserID, time.Time, string) struct{} { return struct{}{} },
+	)
+}
+
+// MigrateEvidenceTypes reclassifies every evidence for which match returns true
+// by applying transform to its type. Each migrated type is validated as by
+// WithEvidenceType. Non-matching evidence, and evidence whose migration fails,
+// is returned unchanged; failures are reported per evidence.
+func MigrateEvidenceTypes(
+	evidence []*Evidence,
+	match func(*Evidence) bool,
+	transform func(EvidenceType) (EvidenceType, error),
+) ([]*Evidence, []error) {
+	result := make([]*Evidence, len(evidence))
+	var errs []error
+
+	for i, e := range evidence {
+		result[i] = e
+		if !match(e) {
+			continue
+		}
+
+		et, err := transform(e.evidenceType)
+		if err == nil {
+			var migrated *Evidence
+			if migrated, err = e.WithEvidenceType(et); err == nil {
+				result[i] = migrated
+				continue
+			}
+		}
+		errs = append(errs, fmt.Errorf("evidence %s: %w", e.id, err))
+	}
+
+	return result, errs
+}
+
+// PrioritizeReviewQueue returns the pending evidence ordered for review:
+// evidence for controls tied to higher risk levels first, ties broken by
+// oldest collection date. Evidence for controls missing from controlRisk sorts last.
+// The input slice is not modified.
+func PrioritizeReviewQueue(pending []*Evidence, controlRisk map[shared.ControlID]RiskLevel) []*Evidence {
+	queue := make([]*Evidence, len(pending))
+	copy(queue, pending)
+
+	// Unmapped controls rank below every defined level, including Negligible
+	rank := func(e *Evidence) int {
+		if l, ok := controlRisk[e.controlID]; ok {
+			return int(l)
+		}
+		return int(RiskLevelNegligible) - 1
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		li, lj := rank(queue[i]), rank(queue[j])
+		if li != lj {
+			return li > lj
+		}
+		return queue[i].collectedAt.Before(queue[j].collectedAt)
+	})
+	return queue
+}
+
+// EvidenceAging describes how close a piece of evidence is to expiring.
+type EvidenceAging struct {
+	EvidenceID shared.EvidenceID
+	ControlID  shared.ControlID
+	Status     EvidenceStatus
+	// DaysUntilExpiry is the number of whole days until expiry, rounded
+	// down, so it is negative once expired. It is nil for evidence that
+	// never expires.
+	DaysUntilExpiry *int
+}
+
+// EvidenceAgingReport lists the evidence ordered by expiry, most urgent
+// (already expired or soonest to expire) first. Evidence without an
+// expiration comes last, in input order.
+func EvidenceAgingReport(evidence []*Evidence, now time.Time) []EvidenceAging {
+	sorted := make([]*Evidence, len(evidence))
+	copy(sorted, evidence)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ei, ej := sorted[i].expiresAt, sorted[j].expiresAt
+		if ei == nil || ej == nil {
+			return ei != nil && ej == nil
+		}
+		return ei.Before(*ej)
+	})
+
+	report := make([]EvidenceAging, len(sorted))
+	for i, e := range sorted {
+		report[i] = EvidenceAging{
+			EvidenceID: e.id,
+			ControlID:  e.controlID,
+			Status:     e.StatusAt(now, 0),
+		}
+		if e.expiresAt != nil {
+			days := int(math.Floor(e.expiresAt.Sub(now).Hours() / 24))
+			report[i].DaysUntilExpiry = &days
+		}
+	}
+	return report
+}
+
+// GetEvidenceTypeLabel returns a label for the evidence type in the default language.
 func GetEvidenceTypeLabel(et EvidenceType) string {
+	return GetEvidenceTypeLabelIn(et, shared.DefaultLanguage)
+}
+
+// GetEvidenceTypeLabelIn returns a localized label for the evidence type
+// using the templates in shared.DefaultCatalog.
+func GetEvidenceTypeLabelIn(et EvidenceType, lang shared.Language) string {
 	return MatchEvidenceType(
 		et,
-		func(_ shared.URL, ft FileType) string { return fmt.Sprintf("ドキュメント (%s)", ft) },
+		func(_ shared.URL, ft FileType) string {
+			return shared.DefaultCatalog.Format(lang, MsgEvidenceDocument, ft)
+		},
 		func(_ shared.URL, capturedAt time.Time) string {
-			return fmt.Sprintf("スクリーンショット (%s)", capturedAt.Format(time.RFC3339))
+			return shared.DefaultCatalog.Format(lang, MsgEvidenceScreenshot, capturedAt.Format(time.RFC3339))
 		},
 		func(_ shared.IntegrationID, checkName string, _ time.Time, result CheckResult) string {
-			return fmt.Sprintf("自動チェック: %s (%s)", checkName, result.String())
+			return shared.DefaultCatalog.Format(lang, MsgEvidenceAutomatedCheck, checkName, result.String())
 		},
 		func(_ shared.UserID, reviewedAt time.Time, _ string) string {
-			return fmt.Sprintf("手動レビュー (%s)", reviewedAt.Format(time.RFC3339))
+			return shared.DefaultCatalog.Format(lang, MsgEvidenceManualReview, reviewedAt.Format(time.RFC3339))
 		},
 	)
 }
@@ -0,0 +1,495 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// newTestEvidenceOfType creates evidence of the given type, collected at testNow.
+func newTestEvidenceOfType(t *testing.T, id string, et EvidenceType) *Evidence {
+	t.Helper()
+	e, err := NewEvidenceAt(testClock, CreateEvidenceInput{
+		ID:           id,
+		ControlID:    "ctrl-1",
+		EvidenceType: et,
+		CollectedAt:  testNow,
+	})
+	if err != nil {
+		t.Fatalf("NewEvidenceAt(%s) error = %v", id, err)
+	}
+	return e
+}
+
+func TestMigrateScreenshotsToDocuments(t *testing.T) {
+	screenshot, err := NewScreenshot("https://evidence.example.com/shot.png", testNow)
+	if err != nil {
+		t.Fatalf("NewScreenshot() error = %v", err)
+	}
+	// A transform that drops the type must be caught by validation
+	unmappable, err := NewScreenshot("https://evidence.example.com/old.png", testNow.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("NewScreenshot() error = %v", err)
+	}
+	review := ManualReview{ReviewerID: "user-1", ReviewedAt: testNow}
+
+	evidence := []*Evidence{
+		newTestEvidenceOfType(t, "ev-1", screenshot),
+		newTestEvidenceOfType(t, "ev-2", review),
+		newTestEvidenceOfType(t, "ev-3", unmappable),
+	}
+
+	migrated, errs := MigrateEvidenceTypes(
+		evidence,
+		func(e *Evidence) bool { return EvidenceTypeKind(e.EvidenceType()) == EvidenceTypeKind(Screenshot{}) },
+		func(et EvidenceType) (EvidenceType, error) {
+			s := et.(Screenshot)
+			if s.CapturedAt.Before(testNow) {
+				return nil, nil
+			}
+			return NewDocument(s.ImageURL.String(), FileTypePNG)
+		},
+	)
+
+	doc, ok := migrated[0].EvidenceType().(Document)
+	if !ok || doc.FileURL.String() != "https://evidence.example.com/shot.png" {
+		t.Errorf("migrated[0] type = %v, want a Document with the screenshot URL", migrated[0].EvidenceType())
+	}
+	if migrated[1] != evidence[1] {
+		t.Error("non-matching evidence was replaced")
+	}
+	if migrated[2] != evidence[2] {
+		t.Error("evidence whose migration failed was replaced")
+	}
+	if len(errs) != 1 {
+		t.Fatalf("MigrateEvidenceTypes() errors = %v, want one for ev-3", errs)
+	}
+	if !errors.Is(errs[0], shared.ErrRequired) || !strings.Contains(errs[0].Error(), "ev-3") {
+		t.Errorf("errs[0] = %v, want REQUIRED for ev-3", errs[0])
+	}
+	if _, ok := evidence[0].EvidenceType().(Screenshot); !ok {
+		t.Error("MigrateEvidenceTypes() modified the original evidence")
+	}
+}
+
+func TestWithEvidenceTypeRequiresCheckResult(t *testing.T) {
+	e := newTestEvidence(t, "ev-1", "ctrl-1", testNow, nil)
+
+	_, err := e.WithEvidenceType(AutomatedCheck{IntegrationID: "int-1", CheckName: "mfa-enforced", LastRunAt: testNow})
+	if !hasCode(err, "REQUIRED") {
+		t.Errorf("WithEvidenceType() without a result error = %v, want REQUIRED", err)
+	}
+	if _, err := e.WithEvidenceType(nil); !hasCode(err, "REQUIRED") {
+		t.Errorf("WithEvidenceType(nil) error = %v, want REQUIRED", err)
+	}
+}
+
+func TestEvidenceStatusAtWarnWindow(t *testing.T) {
+	expiresAt := testNow.Add(7 * 24 * time.Hour)
+	e := newTestEvidence(t, "ev-1", "ctrl-1", testNow.Add(-time.Hour), timePtr(expiresAt))
+	perpetual := newTestEvidence(t, "ev-2", "ctrl-1", testNow.Add(-time.Hour), nil)
+	week := 7 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		evidence *Evidence
+		now      time.Time
+		window   time.Duration
+		want     EvidenceStatus
+	}{
+		{"outside the window", e, testNow.Add(-time.Second), week, EvidenceStatusValid},
+		{"at the window start", e, testNow, week, EvidenceStatusExpiringSoon},
+		{"window disabled", e, testNow, 0, EvidenceStatusValid},
+		{"at expiry", e, expiresAt, week, EvidenceStatusExpiringSoon},
+		{"after expiry", e, expiresAt.Add(time.Second), week, EvidenceStatusExpired},
+		{"never expires", perpetual, testNow, week, EvidenceStatusValid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.evidence.StatusAt(tt.now, tt.window); got != tt.want {
+				t.Errorf("StatusAt() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// sampleEvidenceTypes returns one valid instance of each evidence type variant.
+func sampleEvidenceTypes(t *testing.T) (Document, Screenshot, AutomatedCheck, ManualReview) {
+	t.Helper()
+	doc, err := NewDocument("https://evidence.example.com/policy.pdf", FileTypePDF)
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	shot, err := NewScreenshot("https://evidence.example.com/console.png", testNow)
+	if err != nil {
+		t.Fatalf("NewScreenshot() error = %v", err)
+	}
+	check := AutomatedCheck{IntegrationID: "int-1", CheckName: "mfa-enforced", LastRunAt: testNow, Result: CheckPassed{}}
+	review := ManualReview{ReviewerID: "user-1", ReviewedAt: testNow, Notes: "ok"}
+	return doc, shot, check, review
+}
+
+func TestEvidenceTrustLevel(t *testing.T) {
+	doc, shot, check, review := sampleEvidenceTypes(t)
+	tests := []struct {
+		et   EvidenceType
+		want TrustLevel
+	}{
+		{doc, TrustLevelUntrusted},
+		{shot, TrustLevelUntrusted},
+		{check, TrustLevelSystemVerified},
+		{review, TrustLevelSelfAttested},
+	}
+	for _, tt := range tests {
+		if got := newTestEvidenceOfType(t, "ev-1", tt.et).TrustLevel(); got != tt.want {
+			t.Errorf("TrustLevel() of %s = %s, want %s", tt.et, got, tt.want)
+		}
+	}
+
+	e := newTestEvidenceOfType(t, "ev-1", doc)
+	overridden, err := e.WithTrustLevel(TrustLevelSystemVerified)
+	if err != nil {
+		t.Fatalf("WithTrustLevel() error = %v", err)
+	}
+	if overridden.TrustLevel() != TrustLevelSystemVerified || e.TrustLevel() != TrustLevelUntrusted {
+		t.Error("WithTrustLevel() did not override only the copy")
+	}
+	for _, level := range []TrustLevel{0, TrustLevelSystemVerified + 1} {
+		if _, err := e.WithTrustLevel(level); !hasCode(err, "INVALID_TRUST_LEVEL") {
+			t.Errorf("WithTrustLevel(%d) error = %v, want INVALID_TRUST_LEVEL", level, err)
+		}
+	}
+}
+
+func TestControlMinimumTrustMet(t *testing.T) {
+	doc, _, check, _ := sampleEvidenceTypes(t)
+	c := newTestControl(t, "ctrl-1")
+	upload := newTestEvidenceOfType(t, "ev-1", doc)
+	automated := newTestEvidenceOfType(t, "ev-2", check)
+
+	if c.MinimumTrustMet([]*Evidence{upload}, TrustLevelSelfAttested, testNow) {
+		t.Error("MinimumTrustMet() = true with only an untrusted upload")
+	}
+	if !c.MinimumTrustMet([]*Evidence{upload, automated}, TrustLevelSelfAttested, testNow) {
+		t.Error("MinimumTrustMet() = false with a system-verified check")
+	}
+}
+
+func TestNewEvidenceAtUsesClock(t *testing.T) {
+	_, _, _, review := sampleEvidenceTypes(t)
+	input := CreateEvidenceInput{
+		ID:           "ev-1",
+		ControlID:    "ctrl-1",
+		EvidenceType: review,
+		CollectedAt:  testNow.Add(time.Hour),
+	}
+
+	if _, err := NewEvidenceAt(testClock, input); !hasCode(err, "INVALID_COLLECTION_DATE") {
+		t.Errorf("NewEvidenceAt() error = %v, want INVALID_COLLECTION_DATE", err)
+	}
+
+	later := shared.FixedClock{Time: testNow.Add(2 * time.Hour)}
+	e, err := NewEvidenceAt(later, input)
+	if err != nil {
+		t.Fatalf("NewEvidenceAt() error = %v", err)
+	}
+	if !e.CreatedAt().Equal(later.Time) {
+		t.Errorf("CreatedAt() = %s, want %s", e.CreatedAt(), later.Time)
+	}
+}
+
+func TestNewEvidenceExpiresAfterCollection(t *testing.T) {
+	_, _, _, review := sampleEvidenceTypes(t)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantErr   bool
+	}{
+		{"after collection", testNow.Add(time.Nanosecond), false},
+		{"equal to collection", testNow, true},
+		{"before collection", testNow.Add(-time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEvidenceAt(testClock, CreateEvidenceInput{
+				ID:           "ev-1",
+				ControlID:    "ctrl-1",
+				EvidenceType: review,
+				CollectedAt:  testNow,
+				ExpiresAt:    &tt.expiresAt,
+			})
+			if got := hasCode(err, "EXPIRES_BEFORE_COLLECTION"); got != tt.wantErr {
+				t.Errorf("NewEvidenceAt() error = %v, want EXPIRES_BEFORE_COLLECTION: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("NewEvidenceAt() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPrioritizeReviewQueue(t *testing.T) {
+	oldest := newTestEvidence(t, "ev-old", "ctrl-low", testNow.Add(-72*time.Hour), nil)
+	unmapped := newTestEvidence(t, "ev-unmapped", "ctrl-unknown", testNow.Add(-96*time.Hour), nil)
+	negligible := newTestEvidence(t, "ev-negligible", "ctrl-negligible", testNow.Add(-24*time.Hour), nil)
+	older := newTestEvidence(t, "ev-older", "ctrl-low", testNow.Add(-48*time.Hour), nil)
+	urgent := newTestEvidence(t, "ev-urgent", "ctrl-critical", testNow.Add(-time.Hour), nil)
+	pending := []*Evidence{oldest, unmapped, negligible, older, urgent}
+
+	got := PrioritizeReviewQueue(pending, map[shared.ControlID]RiskLevel{
+		"ctrl-negligible": RiskLevelNegligible,
+		"ctrl-low":        RiskLevelLow,
+		"ctrl-critical":   RiskLevelCritical,
+	})
+
+	var ids []shared.EvidenceID
+	for _, e := range got {
+		ids = append(ids, e.ID())
+	}
+	// The unmapped evidence is the oldest, but still sorts after Negligible
+	want := []shared.EvidenceID{"ev-urgent", "ev-old", "ev-older", "ev-negligible", "ev-unmapped"}
+	if !slices.Equal(ids, want) {
+		t.Errorf("PrioritizeReviewQueue() = %v, want %v", ids, want)
+	}
+	if pending[0] != oldest {
+		t.Error("PrioritizeReviewQueue() reordered the input slice")
+	}
+}
+
+func TestEvidenceStatusForCheckResults(t *testing.T) {
+	tests := []struct {
+		result      CheckResult
+		want        EvidenceStatus
+		wantWarning string
+		actionable  bool
+	}{
+		{CheckPassed{}, EvidenceStatusValid, "", false},
+		{CheckWarning{Reason: "certificate expires in 10 days"}, EvidenceStatusValid, "certificate expires in 10 days", true},
+		{CheckFailed{Reason: "MFA disabled"}, EvidenceStatusRejected, "", true},
+		{CheckSkipped{Reason: "integration paused"}, EvidenceStatusPending, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result.String(), func(t *testing.T) {
+			e := newTestEvidenceOfType(t, "ev-1", AutomatedCheck{
+				IntegrationID: "int-1",
+				CheckName:     "mfa-enforced",
+				LastRunAt:     testNow,
+				Result:        tt.result,
+			})
+			if got := e.StatusAt(testNow, 0); got != tt.want {
+				t.Errorf("StatusAt() = %s, want %s", got, tt.want)
+			}
+			if got, ok := e.Warning(); got != tt.wantWarning || ok != (tt.wantWarning != "") {
+				t.Errorf("Warning() = %q, %v, want %q", got, ok, tt.wantWarning)
+			}
+			if got := IsCheckActionable(tt.result); got != tt.actionable {
+				t.Errorf("IsCheckActionable() = %v, want %v", got, tt.actionable)
+			}
+		})
+	}
+}
+
+func TestCheckWarningString(t *testing.T) {
+	if got := (CheckWarning{Reason: "slow"}).String(); got != "Warning: slow" {
+		t.Errorf("String() = %q, want %q", got, "Warning: slow")
+	}
+}
+
+func TestMatchCheckResult(t *testing.T) {
+	describe := func(r CheckResult) string {
+		return MatchCheckResult(
+			r,
+			func() string { return "passed" },
+			func(reason string) string { return "failed: " + reason },
+			func(reason string) string { return "skipped: " + reason },
+			func(reason string) string { return "warning: " + reason },
+		)
+	}
+
+	tests := []struct {
+		result CheckResult
+		want   string
+	}{
+		{CheckPassed{}, "passed"},
+		{CheckFailed{Reason: "a"}, "failed: a"},
+		{CheckSkipped{Reason: "b"}, "skipped: b"},
+		{CheckWarning{Reason: "c"}, "warning: c"},
+	}
+	for _, tt := range tests {
+		if got := describe(tt.result); got != tt.want {
+			t.Errorf("MatchCheckResult(%v) = %q, want %q", tt.result, got, tt.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MatchCheckResult(nil) did not panic")
+		}
+	}()
+	describe(nil)
+}
+
+func TestEvidenceSourceClassification(t *testing.T) {
+	doc, shot, check, review := sampleEvidenceTypes(t)
+	tests := []struct {
+		et            EvidenceType
+		kind          string
+		automated     bool
+		needsReviewer bool
+	}{
+		{doc, "manual", false, false},
+		{shot, "captured", false, true},
+		{check, "automated", true, false},
+		{review, "manual", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(EvidenceTypeKind(tt.et), func(t *testing.T) {
+			if got := EvidenceSourceKind(tt.et); got != tt.kind {
+				t.Errorf("EvidenceSourceKind() = %q, want %q", got, tt.kind)
+			}
+			if got := IsAutomatedEvidence(tt.et); got != tt.automated {
+				t.Errorf("IsAutomatedEvidence() = %v, want %v", got, tt.automated)
+			}
+			if got := RequiresHumanReview(tt.et); got != tt.needsReviewer {
+				t.Errorf("RequiresHumanReview() = %v, want %v", got, tt.needsReviewer)
+			}
+		})
+	}
+}
+
+func TestEvidenceValidateReconstructed(t *testing.T) {
+	e := newTestEvidence(t, "ev-1", "ctrl-1", testNow, timePtr(testNow.Add(time.Hour))).Clone()
+	if err := e.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	e.expiresAt = timePtr(testNow.Add(-time.Hour))
+	if err := e.Validate(); !hasCode(err, "EXPIRES_BEFORE_COLLECTION") {
+		t.Errorf("Validate() error = %v, want EXPIRES_BEFORE_COLLECTION", err)
+	}
+}
+
+func TestEvidenceAgingReport(t *testing.T) {
+	lastMonth := testNow.AddDate(0, -1, 0)
+	evidence := []*Evidence{
+		newTestEvidence(t, "ev-perpetual-1", "ctrl-1", testNow, nil),
+		newTestEvidence(t, "ev-expiring", "ctrl-1", testNow, timePtr(testNow.Add(36*time.Hour))),
+		newTestEvidence(t, "ev-expired", "ctrl-2", lastMonth, timePtr(testNow.Add(-12*time.Hour))),
+		newTestEvidence(t, "ev-perpetual-2", "ctrl-2", testNow, nil),
+	}
+
+	got := EvidenceAgingReport(evidence, testNow)
+
+	want := []struct {
+		id     shared.EvidenceID
+		status EvidenceStatus
+		days   *int
+	}{
+		{"ev-expired", EvidenceStatusExpired, intPtr(-1)},
+		{"ev-expiring", EvidenceStatusValid, intPtr(1)},
+		{"ev-perpetual-1", EvidenceStatusValid, nil},
+		{"ev-perpetual-2", EvidenceStatusValid, nil},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("EvidenceAgingReport() returned %d items, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.EvidenceID != w.id || g.Status != w.status {
+			t.Errorf("[%d] = %s %s, want %s %s", i, g.EvidenceID, g.Status, w.id, w.status)
+		}
+		if (g.DaysUntilExpiry == nil) != (w.days == nil) || (w.days != nil && *g.DaysUntilExpiry != *w.days) {
+			t.Errorf("[%d] DaysUntilExpiry = %v, want %v", i, g.DaysUntilExpiry, w.days)
+		}
+	}
+}
+
+func intPtr(n int) *int { return &n }
+
+func TestEvidenceStatusWithMaxAge(t *testing.T) {
+	const maxAge = 24 * time.Hour
+	check := func(lastRunAt time.Time) *Evidence {
+		return newTestEvidenceOfType(t, "ev-1", AutomatedCheck{
+			IntegrationID: "int-1",
+			CheckName:     "backup-verified",
+			LastRunAt:     lastRunAt,
+			Result:        CheckPassed{},
+		})
+	}
+	lastRunAt := testNow.Add(-maxAge)
+
+	tests := []struct {
+		name        string
+		e           *Evidence
+		now         time.Time
+		maxAge      time.Duration
+		want        EvidenceStatus
+		wantDefault EvidenceStatus // StatusAt, using DefaultAutomatedCheckMaxAge
+	}{
+		{"exactly maxAge old", check(lastRunAt), testNow, maxAge, EvidenceStatusValid, EvidenceStatusValid},
+		{"one second past maxAge", check(lastRunAt), testNow.Add(time.Second), maxAge, EvidenceStatusStale, EvidenceStatusValid},
+		{"never run", check(time.Time{}), testNow, maxAge, EvidenceStatusStale, EvidenceStatusStale},
+		{"no max age", check(time.Time{}), testNow, 0, EvidenceStatusValid, EvidenceStatusStale},
+		{"past the default max age", check(testNow.Add(-DefaultAutomatedCheckMaxAge - time.Second)), testNow, 0,
+			EvidenceStatusValid, EvidenceStatusStale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.StatusWithMaxAge(tt.now, tt.maxAge); got != tt.want {
+				t.Errorf("StatusWithMaxAge() = %s, want %s", got, tt.want)
+			}
+			if got := tt.e.StatusAt(tt.now, 0); got != tt.wantDefault {
+				t.Errorf("StatusAt() = %s, want %s", got, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestEvidenceSupersedes(t *testing.T) {
+	lastMonth := testNow.AddDate(0, -1, 0)
+	old := newTestEvidence(t, "ev-old", "ctrl-1", lastMonth, nil)
+
+	tests := []struct {
+		name      string
+		newer     *Evidence
+		wantCodes []string
+	}{
+		{"same control, later", newTestEvidence(t, "ev-new", "ctrl-1", testNow, nil), nil},
+		{"mismatched control", newTestEvidence(t, "ev-new", "ctrl-2", testNow, nil), []string{"EVIDENCE_CONTROL_MISMATCH"}},
+		{"mismatched control, same time", newTestEvidence(t, "ev-new", "ctrl-2", lastMonth, nil),
+			[]string{"EVIDENCE_CONTROL_MISMATCH", "INVALID_SUPERSESSION"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.newer.Supersedes(old)
+			if tt.wantCodes == nil {
+				if err != nil {
+					t.Fatalf("Supersedes() error = %v", err)
+				}
+				if id := got.SupersedesID(); id == nil || *id != "ev-old" {
+					t.Errorf("SupersedesID() = %v, want ev-old", id)
+				}
+				if tt.newer.SupersedesID() != nil {
+					t.Error("Supersedes() modified the receiver")
+				}
+				return
+			}
+			for _, code := range tt.wantCodes {
+				if !hasCode(err, code) {
+					t.Errorf("Supersedes() error = %v, want %s", err, code)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// riskCSVHeader lists the columns written by WriteRisksCSV.
+var riskCSVHeader = []string{
+	"id", "title", "category",
+	"inherent_value", "inherent_label",
+	"residual_value", "residual_label",
+	"status", "owner",
+}
+
+// WriteRisksCSV writes the risks as CSV with a header row. The status column
+// uses the English String form of the status.
+func WriteRisksCSV(w io.Writer, risks []*Risk) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(riskCSVHeader); err != nil {
+		return err
+	}
+	for _, r := range risks {
+		record := []string{
+			string(r.id),
+			r.title,
+			string(r.category),
+			strconv.Itoa(r.inherentScore.value),
+			r.inherentScore.label,
+			strconv.Itoa(r.residualScore.value),
+			r.residualScore.label,
+			r.status.String(),
+			string(r.ownerID),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// controlCSVHeader lists the columns written by WriteControlsCSV.
+var controlCSVHeader = []string{
+	"id", "framework_id", "code", "title", "status", "completion_percent", "owner",
+}
+
+// WriteControlsCSV writes the controls as CSV with a header row. The status
+// column uses the English String form; completion_percent is empty for
+// NotApplicable controls (see ControlCompletionPercent).
+func WriteControlsCSV(w io.Writer, controls []*Control) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(controlCSVHeader); err != nil {
+		return err
+	}
+	for _, c := range controls {
+		completion := ""
+		if pct, counts := ControlCompletionPercent(c.status); counts {
+			completion = strconv.Itoa(pct)
+		}
+		record := []string{
+			string(c.id),
+			string(c.frameworkID),
+			c.code,
+			c.title,
+			c.status.String(),
+			completion,
+			string(c.ownerID),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// evidenceCSVHeader lists the columns written by WriteEvidenceCSV.
+var evidenceCSVHeader = []string{
+	"id", "control_id", "type", "collected_at", "expires_at", "status",
+}
+
+// WriteEvidenceCSV writes the evidence as CSV with a header row, with the
+// status evaluated at the current time.
+func WriteEvidenceCSV(w io.Writer, evidence []*Evidence) error {
+	return WriteEvidenceCSVAt(w, evidence, time.Now())
+}
+
+// WriteEvidenceCSVAt is like WriteEvidenceCSV but evaluates status at the given time.
+// Timestamps are RFC 3339; expires_at is empty for evidence that never expires.
+func WriteEvidenceCSVAt(w io.Writer, evidence []*Evidence, now time.Time) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(evidenceCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range evidence {
+		expiresAt := ""
+		if e.expiresAt != nil {
+			expiresAt = e.expiresAt.Format(time.RFC3339)
+		}
+		record := []string{
+			string(e.id),
+			string(e.controlID),
+			EvidenceTypeKind(e.evidenceType),
+			e.collectedAt.Format(time.RFC3339),
+			expiresAt,
+			string(e.StatusAt(now, 0)),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// RenderFrameworkMarkdown renders a Markdown summary of the framework for
+// audit hand-offs, with status labels in the default language.
+func RenderFrameworkMarkdown(f *Framework, controls []*Control) string {
+	return RenderFrameworkMarkdownIn(f, controls, shared.DefaultLanguage)
+}
+
+// RenderFrameworkMarkdownIn is like RenderFrameworkMarkdown with status labels in lang.
+// Controls are listed in framework order; NotApplicable controls are listed
+// but excluded from the compliance percentage, as in ComputeFrameworkCompliance.
+func RenderFrameworkMarkdownIn(f *Framework, controls []*Control, lang shared.Language) string {
+	compliance := ComputeFrameworkCompliance(f, controls)
+
+	byID := make(map[shared.ControlID]*Control, len(controls))
+	for _, c := range controls {
+		byID[c.id] = c
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s %s\n\n", f.name, f.version)
+	fmt.Fprintf(&b, "- Type: %s\n", f.fwType)
+	fmt.Fprintf(&b, "- Status: %s\n", f.status)
+	fmt.Fprintf(&b, "- Compliance: %d%% (%s)\n\n", compliance.Implemented.Value(), compliance.Health)
+
+	b.WriteString("| Code | Title | Status | Completion |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, id := range f.controlIDs {
+		c, ok := byID[id]
+		if !ok {
+			continue
+		}
+		completion := "N/A"
+		if pct, counts := ControlCompletionPercent(c.status); counts {
+			completion = fmt.Sprintf("%d%%", pct)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+			markdownCell(c.code),
+			markdownCell(c.title),
+			markdownCell(GetControlStatusLabelIn(c.status, lang)),
+			completion,
+		)
+	}
+
+	if len(compliance.MissingControlIDs) > 0 {
+		b.WriteString("\nMissing controls:\n\n")
+		for _, id := range compliance.MissingControlIDs {
+			fmt.Fprintf(&b, "- %s\n", id)
+		}
+	}
+
+	return b.String()
+}
+
+// markdownCell escapes text for use in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
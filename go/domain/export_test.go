@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"encoding/csv"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// readCSV parses CSV output, failing the test if it is malformed.
+func readCSV(t *testing.T, s string) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(strings.NewReader(s)).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll() error = %v", err)
+	}
+	return records
+}
+
+func TestWriteRisksCSV(t *testing.T) {
+	r := withResidual(t, newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelMedium), RiskLevelLow, RiskLevelMedium)
+	r = withTitle(t, r, "Phishing, spear and bulk")
+
+	var b strings.Builder
+	if err := WriteRisksCSV(&b, []*Risk{r}); err != nil {
+		t.Fatalf("WriteRisksCSV() error = %v", err)
+	}
+
+	records := readCSV(t, b.String())
+	if len(records) != 2 {
+		t.Fatalf("WriteRisksCSV() wrote %d records, want header and one row", len(records))
+	}
+	if !slices.Equal(records[0], riskCSVHeader) {
+		t.Errorf("header = %v, want %v", records[0], riskCSVHeader)
+	}
+	want := []string{
+		"risk-1", "Phishing, spear and bulk", "Technical",
+		"6", "Medium",
+		"2", "Low",
+		"Identified (2024-04-01T09:00:00Z)", "user-1",
+	}
+	if !slices.Equal(records[1], want) {
+		t.Errorf("row = %v, want %v", records[1], want)
+	}
+}
+
+func TestWriteControlsCSVComputedColumns(t *testing.T) {
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	controls := []*Control{
+		newTestControl(t, "ctrl-1", InProgress{Progress: half}),
+		newTestControl(t, "ctrl-2", InProgress{Progress: half}, Implemented{ImplementedAt: testNow}),
+		newTestControl(t, "ctrl-3", NotApplicable{Reason: "no cardholder data"}),
+	}
+
+	var b strings.Builder
+	if err := WriteControlsCSV(&b, controls); err != nil {
+		t.Fatalf("WriteControlsCSV() error = %v", err)
+	}
+
+	records := readCSV(t, b.String())
+	if !slices.Equal(records[0], controlCSVHeader) {
+		t.Errorf("header = %v, want %v", records[0], controlCSVHeader)
+	}
+	want := [][2]string{ // status, completion_percent
+		{"In Progress (50%)", "50"},
+		{"Implemented (2024-04-01T09:00:00Z)", "100"},
+		{"Not Applicable: no cardholder data", ""},
+	}
+	for i, w := range want {
+		row := records[i+1]
+		if row[4] != w[0] || row[5] != w[1] {
+			t.Errorf("%s status, completion = %q, %q, want %q, %q", row[0], row[4], row[5], w[0], w[1])
+		}
+	}
+}
+
+func TestWriteEvidenceCSVComputedColumns(t *testing.T) {
+	lastYear := testNow.AddDate(-1, 0, 0)
+	evidence := []*Evidence{
+		newTestEvidence(t, "ev-1", "ctrl-1", testNow, nil),
+		newTestEvidence(t, "ev-2", "ctrl-1", lastYear, timePtr(lastYear.AddDate(0, 6, 0))),
+	}
+
+	var b strings.Builder
+	if err := WriteEvidenceCSVAt(&b, evidence, testNow); err != nil {
+		t.Fatalf("WriteEvidenceCSVAt() error = %v", err)
+	}
+
+	records := readCSV(t, b.String())
+	want := [][]string{
+		evidenceCSVHeader,
+		{"ev-1", "ctrl-1", "ManualReview", "2024-04-01T09:00:00Z", "", "Valid"},
+		{"ev-2", "ctrl-1", "ManualReview", "2023-04-01T09:00:00Z", "2023-10-01T09:00:00Z", "Expired"},
+	}
+	if !slices.EqualFunc(records, want, slices.Equal[[]string]) {
+		t.Errorf("WriteEvidenceCSVAt() =\n%v\nwant\n%v", records, want)
+	}
+}
+
+func TestRenderFrameworkMarkdownGolden(t *testing.T) {
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	controls := []*Control{
+		newTestControl(t, "ctrl-1", InProgress{Progress: half}, Implemented{ImplementedAt: testNow}),
+		newTestControl(t, "ctrl-2", InProgress{Progress: half}),
+		newTestControl(t, "ctrl-3", NotApplicable{Reason: "no cardholder data | out of scope"}),
+		newTestControl(t, "ctrl-4", InProgress{Progress: half}, Failed{Reason: "audit finding", DetectedAt: testNow}),
+	}
+	f := activeTestFramework(t, "fw-1", "ctrl-1", "ctrl-2", "ctrl-3", "ctrl-4", "ctrl-missing")
+
+	got := RenderFrameworkMarkdown(f, controls)
+
+	golden := filepath.Join("testdata", "framework_report.golden.md")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v (run with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("RenderFrameworkMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -1,7 +1,13 @@
 package domain
 
 import (
+	"context"
+	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/example/grc-domain-models/domain/shared"
 )
@@ -34,6 +40,46 @@ func (t FrameworkType) String() string {
 	}
 }
 
+// frameworkTypes registers the known framework types, parseable by their
+// enum form ("SOC2") and their display form ("SOC 2").
+var frameworkTypes = func() *shared.Enum[FrameworkType] {
+	e := shared.NewEnum(
+		func(t FrameworkType) string { return string(t) },
+		FrameworkTypeSOC2,
+		FrameworkTypeISO27001,
+		FrameworkTypeHIPAA,
+		FrameworkTypePCIDSS,
+		FrameworkTypeGDPR,
+	)
+	for _, t := range e.Values() {
+		e.Alias(t.String(), t)
+	}
+	return e
+}()
+
+// AllFrameworkTypes returns every known framework type.
+func AllFrameworkTypes() []FrameworkType {
+	return frameworkTypes.Values()
+}
+
+// ParseFrameworkType parses a framework type from either its enum form
+// ("SOC2") or its display form ("SOC 2"), case-insensitively.
+func ParseFrameworkType(s string) (FrameworkType, error) {
+	if t, ok := frameworkTypes.Parse(s); ok {
+		return t, nil
+	}
+	return "", shared.NewValidationError(
+		"type",
+		fmt.Sprintf("Unknown framework type: %q", s),
+		"INVALID_FRAMEWORK_TYPE",
+	)
+}
+
+// IsValid reports whether t is one of the known framework types.
+func (t FrameworkType) IsValid() bool {
+	return frameworkTypes.IsValid(t)
+}
+
 // FrameworkStatus represents the status of a framework.
 type FrameworkStatus string
 
@@ -43,15 +89,38 @@ const (
 	FrameworkStatusDeprecated FrameworkStatus = "Deprecated"
 )
 
+// frameworkStatuses lists the declared framework statuses.
+var frameworkStatuses = []FrameworkStatus{FrameworkStatusDraft, FrameworkStatusActive, FrameworkStatusDeprecated}
+
+// frameworkTransitions allows every transition except reactivating a deprecated framework.
+// Statuses outside frameworkStatuses are not checked against it.
+var frameworkTransitions = func() *shared.TransitionTable[FrameworkStatus] {
+	t := shared.NewTransitionTable[FrameworkStatus]()
+	for _, from := range frameworkStatuses {
+		t.Allow(from, frameworkStatuses...)
+	}
+	// Business rule: Cannot reactivate a deprecated framework
+	return t.Deny(FrameworkStatusDeprecated, FrameworkStatusActive, "Cannot reactivate a deprecated framework")
+}()
+
+// AllowedFrameworkTransitions returns the statuses reachable from the given status.
+func AllowedFrameworkTransitions(from FrameworkStatus) []FrameworkStatus {
+	return frameworkTransitions.AllowedFrom(from)
+}
+
 // Framework represents a compliance framework entity.
 type Framework struct {
-	id          shared.FrameworkID
-	fwType      FrameworkType
-	name        string
-	version     string
-	description string
-	status      FrameworkStatus
-	controlIDs  []shared.ControlID
+	id           shared.FrameworkID
+	fwType       FrameworkType
+	name         string
+	version      string
+	description  string
+	status       FrameworkStatus
+	controlIDs   []shared.ControlID
+	deprecatedAt *time.Time   // nil unless the framework is deprecated
+	clock        shared.Clock // stamps updatedAt; nil means the system clock
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
 // Getter methods
@@ -67,6 +136,16 @@ func (f *Framework) ControlIDs() []shared.ControlID {
 	copy(result, f.controlIDs)
 	return result
 }
+func (f *Framework) DeprecatedAt() *time.Time {
+	return cloneTime(f.deprecatedAt)
+}
+func (f *Framework) CreatedAt() time.Time { return f.createdAt }
+func (f *Framework) UpdatedAt() time.Time { return f.updatedAt }
+
+// ControlCount returns the number of controls in the framework.
+func (f *Framework) ControlCount() int {
+	return len(f.controlIDs)
+}
 
 // CreateFrameworkInput holds the input for creating a Framework.
 type CreateFrameworkInput struct {
@@ -81,19 +160,27 @@ var semverPattern = regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`)
 
 // NewFramework creates a new Framework with validation.
 func NewFramework(input CreateFrameworkInput) (*Framework, error) {
+	return NewFrameworkAt(shared.SystemClock{}, input)
+}
+
+// NewFrameworkAt is like NewFramework but stamps creation and later
+// modifications with the given clock.
+func NewFrameworkAt(clock shared.Clock, input CreateFrameworkInput) (*Framework, error) {
 	var errors shared.ValidationErrors
 
 	id, err := shared.NewFrameworkID(input.ID)
 	if err != nil {
-		if ve, ok := err.(shared.ValidationError); ok {
-			errors = append(errors, ve)
-		}
+		errors.AddError("id", err)
 	}
 
 	if input.Name == "" {
 		errors.Add("name", "Framework name is required", "REQUIRED")
 	}
 
+	if !input.Type.IsValid() {
+		errors.Add("type", fmt.Sprintf("Unknown framework type: %s", input.Type), "INVALID_FRAMEWORK_TYPE")
+	}
+
 	if !semverPattern.MatchString(input.Version) {
 		errors.Add("version", "Version must be in semver format (e.g., 1.0 or 1.0.0)", "INVALID_VERSION")
 	}
@@ -102,6 +189,8 @@ func NewFramework(input CreateFrameworkInput) (*Framework, error) {
 		return nil, errors
 	}
 
+	now := clock.Now()
+
 	return &Framework{
 		id:          id,
 		fwType:      input.Type,
@@ -110,18 +199,70 @@ func NewFramework(input CreateFrameworkInput) (*Framework, error) {
 		description: input.Description,
 		status:      FrameworkStatusDraft,
 		controlIDs:  []shared.ControlID{},
+		clock:       clock,
+		createdAt:   now,
+		updatedAt:   now,
 	}, nil
 }
 
+// Equal returns true if both frameworks have the same ID and field values.
+// Creation and modification timestamps are not compared.
+func (f *Framework) Equal(other *Framework) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	sameDeprecation := (f.deprecatedAt == nil) == (other.deprecatedAt == nil)
+	if sameDeprecation && f.deprecatedAt != nil {
+		sameDeprecation = f.deprecatedAt.Equal(*other.deprecatedAt)
+	}
+
+	return f.id == other.id &&
+		f.fwType == other.fwType &&
+		f.name == other.name &&
+		f.version == other.version &&
+		f.description == other.description &&
+		f.status == other.status &&
+		slices.Equal(f.controlIDs, other.controlIDs) &&
+		sameDeprecation
+}
+
 // WithStatus returns a new Framework with the updated status.
+// Deprecating records the current time as the deprecation timestamp;
+// moving out of Deprecated clears it.
 func (f *Framework) WithStatus(newStatus FrameworkStatus) (*Framework, error) {
-	// Business rule: Cannot reactivate a deprecated framework
-	if f.status == FrameworkStatusDeprecated && newStatus == FrameworkStatusActive {
-		return nil, shared.NewValidationError(
-			"status",
-			"Cannot reactivate a deprecated framework",
-			"INVALID_TRANSITION",
-		)
+	return f.WithStatusAt(shared.SystemClock{}, newStatus)
+}
+
+// WithStatusAt is like WithStatus but takes the deprecation timestamp from the given clock.
+func (f *Framework) WithStatusAt(clock shared.Clock, newStatus FrameworkStatus) (*Framework, error) {
+	return f.withStatusAt(context.Background(), newStatus, clock.Now())
+}
+
+// WithStatusCtx is like WithStatusAt but reports the attempt to the
+// TransitionObserver carried by ctx, if any (see WithTransitionObserver).
+func (f *Framework) WithStatusCtx(ctx context.Context, clock shared.Clock, newStatus FrameworkStatus) (*Framework, error) {
+	return f.withStatusAt(ctx, newStatus, clock.Now())
+}
+
+// Deprecate returns a new Framework in Deprecated status, recording when it
+// was retired. Deprecating an already deprecated framework keeps the original timestamp.
+func (f *Framework) Deprecate(at time.Time) (*Framework, error) {
+	return f.withStatusAt(context.Background(), FrameworkStatusDeprecated, at)
+}
+
+// withStatusAt applies the transition and reports the attempt to the
+// TransitionObserver of ctx or the registered one.
+func (f *Framework) withStatusAt(ctx context.Context, newStatus FrameworkStatus, at time.Time) (*Framework, error) {
+	updated, err := f.transitionStatus(newStatus, at)
+	notifyTransition(ctx, EntityRef{Type: "Framework", ID: string(f.id)}, string(f.status), string(newStatus), err)
+	return updated, err
+}
+
+func (f *Framework) transitionStatus(newStatus FrameworkStatus, at time.Time) (*Framework, error) {
+	if slices.Contains(frameworkStatuses, f.status) && slices.Contains(frameworkStatuses, newStatus) {
+		if err := frameworkTransitions.CanTransition(f.status, newStatus); err != nil {
+			return nil, err
+		}
 	}
 
 	// Business rule: Cannot activate a framework without controls
@@ -133,18 +274,87 @@ func (f *Framework) WithStatus(newStatus FrameworkStatus) (*Framework, error) {
 		)
 	}
 
-	controlIDsCopy := make([]shared.ControlID, len(f.controlIDs))
-	copy(controlIDsCopy, f.controlIDs)
+	updated := f.clone()
+	updated.status = newStatus
+	updated.updatedAt = at
+	switch {
+	case newStatus != FrameworkStatusDeprecated:
+		updated.deprecatedAt = nil
+	case f.deprecatedAt == nil:
+		updated.deprecatedAt = &at
+	}
+	return updated, nil
+}
 
-	return &Framework{
-		id:          f.id,
-		fwType:      f.fwType,
-		name:        f.name,
-		version:     f.version,
-		description: f.description,
-		status:      newStatus,
-		controlIDs:  controlIDsCopy,
-	}, nil
+// Validate re-checks the invariants NewFramework and WithStatus enforce. Use
+// it on a Framework reconstructed from storage, which bypasses the constructor.
+func (f *Framework) Validate() error {
+	var errors shared.ValidationErrors
+
+	if _, err := shared.NewFrameworkID(string(f.id)); err != nil {
+		errors.AddError("id", err)
+	}
+
+	if f.name == "" {
+		errors.Add("name", "Framework name is required", "REQUIRED")
+	}
+
+	if !f.fwType.IsValid() {
+		errors.Add("type", fmt.Sprintf("Unknown framework type: %s", f.fwType), "INVALID_FRAMEWORK_TYPE")
+	}
+
+	if !semverPattern.MatchString(f.version) {
+		errors.Add("version", "Version must be in semver format (e.g., 1.0 or 1.0.0)", "INVALID_VERSION")
+	}
+
+	if f.status == FrameworkStatusActive && len(f.controlIDs) == 0 {
+		errors.Add("controlIds", "An active framework must have at least one control", "NO_CONTROLS")
+	}
+
+	return errors.ToError()
+}
+
+// Clone returns a deep copy of the Framework.
+func (f *Framework) Clone() *Framework {
+	return f.deepCopy()
+}
+
+// clone returns a modified copy of the Framework with updatedAt set to now.
+func (f *Framework) clone() *Framework {
+	copied := f.deepCopy()
+	copied.updatedAt = shared.NowFrom(f.clock)
+	return copied
+}
+
+// deepCopy returns a copy of the Framework that shares no mutable state with the original.
+func (f *Framework) deepCopy() *Framework {
+	copied := *f
+	copied.controlIDs = make([]shared.ControlID, len(f.controlIDs))
+	copy(copied.controlIDs, f.controlIDs)
+	copied.deprecatedAt = cloneTime(f.deprecatedAt)
+	return &copied
+}
+
+// WithoutControl returns a new Framework with the control removed.
+// Removing a control that is not in the framework is a no-op.
+func (f *Framework) WithoutControl(controlID shared.ControlID) (*Framework, error) {
+	index := slices.Index(f.controlIDs, controlID)
+	if index < 0 {
+		return f, nil
+	}
+
+	// Business rule: An active framework must keep at least one control
+	if f.status == FrameworkStatusActive && len(f.controlIDs) == 1 {
+		return nil, shared.NewValidationError(
+			"controlIds",
+			"Cannot remove the last control of an active framework",
+			"NO_CONTROLS",
+		)
+	}
+
+	updated := f.clone()
+	updated.controlIDs = slices.Delete(updated.controlIDs, index, index+1)
+	return updated, nil
 }
 
 // WithControl returns a new Framework with the added control.
@@ -156,17 +366,260 @@ func (f *Framework) WithControl(controlID shared.ControlID) *Framework {
 		}
 	}
 
-	newControlIDs := make([]shared.ControlID, len(f.controlIDs)+1)
-	copy(newControlIDs, f.controlIDs)
-	newControlIDs[len(f.controlIDs)] = controlID
+	updated := f.clone()
+	updated.controlIDs = append(updated.controlIDs, controlID)
+	return updated
+}
 
-	return &Framework{
-		id:          f.id,
-		fwType:      f.fwType,
-		name:        f.name,
-		version:     f.version,
-		description: f.description,
-		status:      f.status,
-		controlIDs:  newControlIDs,
+// WithControls returns a new Framework with all of the given controls added
+// in a single pass. IDs already in the framework or repeated among ids are
+// skipped; if nothing new is added, the same Framework is returned.
+func (f *Framework) WithControls(ids ...shared.ControlID) *Framework {
+	seen := make(map[shared.ControlID]bool, len(f.controlIDs)+len(ids))
+	for _, id := range f.controlIDs {
+		seen[id] = true
+	}
+
+	var added []shared.ControlID
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			added = append(added, id)
+		}
+	}
+	if len(added) == 0 {
+		return f
+	}
+
+	updated := f.clone()
+	updated.controlIDs = append(updated.controlIDs, added...)
+	return updated
+}
+
+// parseVersion parses a semver-style version into major, minor and patch.
+// A missing patch component is treated as 0, so "1.0" equals "1.0.0".
+func parseVersion(version string) ([3]int, error) {
+	var parts [3]int
+	if !semverPattern.MatchString(version) {
+		return parts, shared.NewValidationError(
+			"version",
+			"Version must be in semver format (e.g., 1.0 or 1.0.0)",
+			"INVALID_VERSION",
+		)
+	}
+	for i, p := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return parts, shared.NewValidationError("version", "Version component is out of range", "INVALID_VERSION")
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
+
+// CompareVersion returns -1, 0 or 1 depending on whether the framework's
+// version is lower than, equal to, or higher than other.
+func (f *Framework) CompareVersion(other string) (int, error) {
+	current, err := parseVersion(f.version)
+	if err != nil {
+		return 0, err
+	}
+	target, err := parseVersion(other)
+	if err != nil {
+		return 0, err
+	}
+	return slices.Compare(current[:], target[:]), nil
+}
+
+// MajorVersionPolicy decides what happens when an Active framework's major
+// version increases, since the changed requirements need re-approval.
+type MajorVersionPolicy int
+
+const (
+	// MajorVersionRevertToDraft moves the framework back to Draft.
+	MajorVersionRevertToDraft MajorVersionPolicy = iota
+	// MajorVersionReject rejects the change with MAJOR_VERSION_REQUIRES_REAPPROVAL.
+	MajorVersionReject
+)
+
+// WithVersion returns a new Framework with the updated version.
+// Downgrades are rejected with VERSION_DOWNGRADE; an equal version is allowed.
+// An Active framework whose major version increases returns to Draft; see
+// WithVersionPolicy. Minor and patch bumps keep the status.
+func (f *Framework) WithVersion(newVersion string) (*Framework, error) {
+	return f.WithVersionPolicy(newVersion, MajorVersionRevertToDraft)
+}
+
+// WithVersionPolicy is like WithVersion but applies the given policy to
+// major version bumps of an Active framework.
+func (f *Framework) WithVersionPolicy(newVersion string, policy MajorVersionPolicy) (*Framework, error) {
+	cmp, err := f.CompareVersion(newVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Business rule: Framework versions only move forward
+	if cmp > 0 {
+		return nil, shared.NewValidationError(
+			"version",
+			"Cannot downgrade framework version from "+f.version+" to "+newVersion,
+			"VERSION_DOWNGRADE",
+		)
+	}
+
+	updated := f.clone()
+	updated.version = newVersion
+
+	// Business rule: A new major version of an active framework needs re-approval
+	if f.status == FrameworkStatusActive {
+		current, _ := parseVersion(f.version)
+		target, _ := parseVersion(newVersion)
+		if target[0] > current[0] {
+			if policy == MajorVersionReject {
+				return nil, shared.NewValidationError(
+					"version",
+					fmt.Sprintf("Major version change from %s to %s requires re-approval", f.version, newVersion),
+					"MAJOR_VERSION_REQUIRES_REAPPROVAL",
+				)
+			}
+			// Demote through the transition table so observers see Active -> Draft
+			return updated.withStatusAt(context.Background(), FrameworkStatusDraft, updated.updatedAt)
+		}
+	}
+
+	return updated, nil
+}
+
+// ValidateControls checks that every control belongs to the framework and
+// that no two controls share a code.
+func (f *Framework) ValidateControls(controls []*Control) error {
+	var errors shared.ValidationErrors
+
+	for _, c := range controls {
+		if !slices.Contains(f.controlIDs, c.id) {
+			errors.Add(
+				"controlIds",
+				fmt.Sprintf("Control %s is not part of framework %s", c.id, f.id),
+				"CONTROL_NOT_IN_FRAMEWORK",
+			)
+		}
+	}
+	addDuplicateCodeErrors(&errors, controls)
+
+	return errors.ToError()
+}
+
+// ValidateUniqueControlCodes checks that no two controls in the same framework
+// share a code, grouping controls by their FrameworkID.
+func ValidateUniqueControlCodes(controls []*Control) error {
+	var errors shared.ValidationErrors
+
+	byFramework := make(map[shared.FrameworkID][]*Control)
+	var order []shared.FrameworkID
+	for _, c := range controls {
+		if _, ok := byFramework[c.frameworkID]; !ok {
+			order = append(order, c.frameworkID)
+		}
+		byFramework[c.frameworkID] = append(byFramework[c.frameworkID], c)
+	}
+	for _, id := range order {
+		addDuplicateCodeErrors(&errors, byFramework[id])
+	}
+
+	return errors.ToError()
+}
+
+// addDuplicateCodeErrors appends a DUPLICATE_CONTROL_CODE error for each code
+// used by more than one of the controls.
+func addDuplicateCodeErrors(errors *shared.ValidationErrors, controls []*Control) {
+	for _, dup := range duplicateCodes(controls) {
+		ids := make([]string, len(dup.ControlIDs))
+		for i, id := range dup.ControlIDs {
+			ids[i] = string(id)
+		}
+		errors.Add(
+			"code",
+			fmt.Sprintf("Control code %q is used by %s", dup.Code, strings.Join(ids, ", ")),
+			"DUPLICATE_CONTROL_CODE",
+		)
+	}
+}
+
+// DuplicateCode lists the controls sharing a code.
+type DuplicateCode struct {
+	Code       string
+	ControlIDs []shared.ControlID
+}
+
+// duplicateCodes returns each code used by more than one of the controls,
+// in order of first use.
+func duplicateCodes(controls []*Control) []DuplicateCode {
+	byCode := make(map[string][]shared.ControlID)
+	var codes []string
+	for _, c := range controls {
+		if _, ok := byCode[c.code]; !ok {
+			codes = append(codes, c.code)
+		}
+		byCode[c.code] = append(byCode[c.code], c.id)
+	}
+	var result []DuplicateCode
+	for _, code := range codes {
+		if ids := byCode[code]; len(ids) > 1 {
+			result = append(result, DuplicateCode{Code: code, ControlIDs: ids})
+		}
 	}
+	return result
+}
+
+// AuditReport describes discrepancies between a framework and its controls.
+type AuditReport struct {
+	FrameworkID shared.FrameworkID
+	// OrphanReferences are framework control IDs with no matching Control.
+	OrphanReferences []shared.ControlID
+	// UnlinkedControls are controls that point to the framework but are
+	// missing from its ControlIDs.
+	UnlinkedControls []shared.ControlID
+	// DuplicateCodes are codes shared by more than one of the framework's controls.
+	DuplicateCodes []DuplicateCode
+}
+
+// IsConsistent returns true if the audit found no discrepancies.
+func (r AuditReport) IsConsistent() bool {
+	return len(r.OrphanReferences) == 0 && len(r.UnlinkedControls) == 0 && len(r.DuplicateCodes) == 0
+}
+
+// AuditFramework checks a framework and its controls against each other in
+// both directions. A control points to the framework if its FrameworkID is
+// the framework's ID or it belongs to the framework via WithFramework.
+// Results follow framework order for orphans and input order otherwise.
+func AuditFramework(f *Framework, controls []*Control) AuditReport {
+	report := AuditReport{FrameworkID: f.id}
+
+	inFramework := make(map[shared.ControlID]bool, len(f.controlIDs))
+	for _, id := range f.controlIDs {
+		inFramework[id] = true
+	}
+
+	known := make(map[shared.ControlID]bool, len(controls))
+	var members []*Control
+	for _, c := range controls {
+		known[c.id] = true
+		pointsToF := c.frameworkID == f.id || c.BelongsTo(f.id)
+		if pointsToF && !inFramework[c.id] {
+			report.UnlinkedControls = append(report.UnlinkedControls, c.id)
+		}
+		if pointsToF || inFramework[c.id] {
+			members = append(members, c)
+		}
+	}
+
+	for _, id := range f.controlIDs {
+		if !known[id] {
+			report.OrphanReferences = append(report.OrphanReferences, id)
+		}
+	}
+
+	report.DuplicateCodes = duplicateCodes(members)
+
+	return report
 }
@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// activeTestFramework creates an Active framework containing the given controls.
+func activeTestFramework(t *testing.T, id string, controls ...shared.ControlID) *Framework {
+	t.Helper()
+	f, err := newTestFramework(t, id, controls...).WithStatusAt(testClock, FrameworkStatusActive)
+	if err != nil {
+		t.Fatalf("WithStatusAt(Active) error = %v", err)
+	}
+	return f
+}
+
+func TestFrameworkWithoutControl(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2")
+
+	got, err := f.WithoutControl("ctrl-1")
+	if err != nil {
+		t.Fatalf("WithoutControl() error = %v", err)
+	}
+	if !slices.Equal(got.ControlIDs(), []shared.ControlID{"ctrl-2"}) {
+		t.Errorf("ControlIDs() = %v, want [ctrl-2]", got.ControlIDs())
+	}
+	if f.ControlCount() != 2 {
+		t.Error("WithoutControl() modified the original framework")
+	}
+}
+
+func TestFrameworkWithoutMissingControl(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1")
+
+	got, err := f.WithoutControl("ctrl-missing")
+	if err != nil || got != f {
+		t.Errorf("WithoutControl(missing) = %v, %v, want the same framework", got, err)
+	}
+}
+
+func TestFrameworkWithoutLastControl(t *testing.T) {
+	draft := newTestFramework(t, "fw-1", "ctrl-1")
+	got, err := draft.WithoutControl("ctrl-1")
+	if err != nil || got.ControlCount() != 0 {
+		t.Errorf("WithoutControl() on a draft = %v, %v, want an empty framework", got, err)
+	}
+
+	active := activeTestFramework(t, "fw-2", "ctrl-1")
+	if _, err := active.WithoutControl("ctrl-1"); !hasCode(err, "NO_CONTROLS") {
+		t.Errorf("WithoutControl() on an active framework error = %v, want NO_CONTROLS", err)
+	}
+}
+
+func TestFrameworkWithControls(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1")
+
+	got := f.WithControls("ctrl-2", "ctrl-1", "ctrl-3", "ctrl-2")
+	if want := []shared.ControlID{"ctrl-1", "ctrl-2", "ctrl-3"}; !slices.Equal(got.ControlIDs(), want) {
+		t.Errorf("ControlIDs() = %v, want %v", got.ControlIDs(), want)
+	}
+	if f.WithControls("ctrl-1") != f || f.WithControls() != f {
+		t.Error("WithControls() without new IDs should return the same framework")
+	}
+}
+
+func benchmarkControlIDs(n int) []shared.ControlID {
+	ids := make([]shared.ControlID, n)
+	for i := range ids {
+		ids[i] = shared.ControlID(fmt.Sprintf("ctrl-%d", i))
+	}
+	return ids
+}
+
+func benchmarkFramework(b *testing.B) *Framework {
+	f, err := NewFrameworkAt(testClock, CreateFrameworkInput{ID: "fw-1", Type: FrameworkTypeSOC2, Name: "SOC 2", Version: "1.0"})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return f
+}
+
+func BenchmarkFrameworkWithControls(b *testing.B) {
+	f, ids := benchmarkFramework(b), benchmarkControlIDs(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.WithControls(ids...)
+	}
+}
+
+func BenchmarkFrameworkRepeatedWithControl(b *testing.B) {
+	f, ids := benchmarkFramework(b), benchmarkControlIDs(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := f
+		for _, id := range ids {
+			g = g.WithControl(id)
+		}
+	}
+}
+
+func TestFrameworkCompareVersion(t *testing.T) {
+	tests := []struct {
+		current, other string
+		want           int
+	}{
+		{"1.0", "1.0.0", 0},
+		{"1.0.0", "1.0", 0},
+		{"1.2.3", "1.2.3", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.10", "1.9.9", 1},
+		{"2.0.0", "10.0", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+" vs "+tt.other, func(t *testing.T) {
+			f, err := NewFrameworkAt(testClock, CreateFrameworkInput{ID: "fw-1", Type: FrameworkTypeSOC2, Name: "SOC 2", Version: tt.current})
+			if err != nil {
+				t.Fatalf("NewFrameworkAt() error = %v", err)
+			}
+			got, err := f.CompareVersion(tt.other)
+			if err != nil || got != tt.want {
+				t.Errorf("CompareVersion() = %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrameworkWithVersionRejectsDowngrade(t *testing.T) {
+	f := newTestFramework(t, "fw-1") // version 1.0.0
+
+	if _, err := f.WithVersion("1.0"); err != nil {
+		t.Errorf("WithVersion(1.0) error = %v, want equal versions allowed", err)
+	}
+	if _, err := f.WithVersion("0.9"); !hasCode(err, "VERSION_DOWNGRADE") {
+		t.Errorf("WithVersion(0.9) error = %v, want VERSION_DOWNGRADE", err)
+	}
+	if _, err := f.WithVersion("v2"); !hasCode(err, "INVALID_VERSION") {
+		t.Errorf("WithVersion(v2) error = %v, want INVALID_VERSION", err)
+	}
+}
+
+func TestFrameworkDeprecate(t *testing.T) {
+	deprecatedAt := testNow.Add(24 * time.Hour)
+	f, err := activeTestFramework(t, "fw-1", "ctrl-1").Deprecate(deprecatedAt)
+	if err != nil {
+		t.Fatalf("Deprecate() error = %v", err)
+	}
+	if got := f.DeprecatedAt(); got == nil || !got.Equal(deprecatedAt) {
+		t.Fatalf("DeprecatedAt() = %v, want %v", got, deprecatedAt)
+	}
+
+	f = f.WithControl("ctrl-2")
+	if got := f.DeprecatedAt(); got == nil || !got.Equal(deprecatedAt) {
+		t.Errorf("DeprecatedAt() after WithControl = %v, want %v", got, deprecatedAt)
+	}
+
+	if _, err := f.WithStatusAt(testClock, FrameworkStatusActive); err == nil {
+		t.Error("WithStatusAt(Active) error = nil, want a deprecated framework to stay retired")
+	}
+}
+
+func TestFrameworkTypeValidation(t *testing.T) {
+	for _, ft := range AllFrameworkTypes() {
+		t.Run(string(ft), func(t *testing.T) {
+			if !ft.IsValid() {
+				t.Error("IsValid() = false, want true")
+			}
+			if _, err := NewFrameworkAt(testClock, CreateFrameworkInput{ID: "fw-1", Type: ft, Name: "Framework", Version: "1.0.0"}); err != nil {
+				t.Errorf("NewFrameworkAt() error = %v", err)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		ft := FrameworkType("SOX-ish")
+		if ft.IsValid() {
+			t.Error("IsValid() = true, want false")
+		}
+		if got := ft.String(); got != "SOX-ish" {
+			t.Errorf("String() = %q, want the raw value", got)
+		}
+		_, err := NewFrameworkAt(testClock, CreateFrameworkInput{ID: "fw-1", Type: ft, Name: "Framework", Version: "1.0.0"})
+		if !hasCode(err, "INVALID_FRAMEWORK_TYPE") {
+			t.Errorf("NewFrameworkAt() error = %v, want INVALID_FRAMEWORK_TYPE", err)
+		}
+	})
+}
+
+func TestFrameworkControlIDsAreNotAliased(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2")
+	clone := f.Clone()
+
+	f.ControlIDs()[0] = "mutated"
+	clone.ControlIDs()[1] = "mutated"
+
+	want := []shared.ControlID{"ctrl-1", "ctrl-2"}
+	if got := f.ControlIDs(); !slices.Equal(got, want) {
+		t.Errorf("ControlIDs() = %v, want %v", got, want)
+	}
+	if got := clone.ControlIDs(); !slices.Equal(got, want) {
+		t.Errorf("clone ControlIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestFrameworkValidateReconstructed(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1").Clone()
+	if err := f.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	f.fwType = "SOX-ish"
+	if err := f.Validate(); !hasCode(err, "INVALID_FRAMEWORK_TYPE") {
+		t.Errorf("Validate() error = %v, want INVALID_FRAMEWORK_TYPE", err)
+	}
+}
+
+func TestAuditFramework(t *testing.T) {
+	f := newTestFramework(t, "fw-1", "ctrl-1", "ctrl-2", "ctrl-orphan")
+
+	duplicate := newTestControl(t, "ctrl-2").Clone()
+	duplicate.code = "CODE-ctrl-1"
+	unlinked, err := newTestControl(t, "ctrl-3").WithFramework("fw-1")
+	if err != nil {
+		t.Fatalf("WithFramework() error = %v", err)
+	}
+	unrelated := newTestControl(t, "ctrl-4")
+
+	report := AuditFramework(f, []*Control{newTestControl(t, "ctrl-1"), duplicate, unlinked, unrelated})
+
+	if !slices.Equal(report.OrphanReferences, []shared.ControlID{"ctrl-orphan"}) {
+		t.Errorf("OrphanReferences = %v, want [ctrl-orphan]", report.OrphanReferences)
+	}
+	if !slices.Equal(report.UnlinkedControls, []shared.ControlID{"ctrl-3"}) {
+		t.Errorf("UnlinkedControls = %v, want [ctrl-3]", report.UnlinkedControls)
+	}
+	if len(report.DuplicateCodes) != 1 ||
+		report.DuplicateCodes[0].Code != "CODE-ctrl-1" ||
+		!slices.Equal(report.DuplicateCodes[0].ControlIDs, []shared.ControlID{"ctrl-1", "ctrl-2"}) {
+		t.Errorf("DuplicateCodes = %+v, want CODE-ctrl-1 on ctrl-1 and ctrl-2", report.DuplicateCodes)
+	}
+	if report.IsConsistent() {
+		t.Error("IsConsistent() = true, want false")
+	}
+
+	consistent := AuditFramework(newTestFramework(t, "fw-2", "ctrl-4"), []*Control{unrelated})
+	if !consistent.IsConsistent() {
+		t.Errorf("AuditFramework() = %+v, want consistent", consistent)
+	}
+}
+
+func TestParseFrameworkType(t *testing.T) {
+	tests := []struct {
+		in   string
+		want FrameworkType
+	}{
+		{"SOC2", FrameworkTypeSOC2},
+		{"SOC 2", FrameworkTypeSOC2},
+		{"soc 2", FrameworkTypeSOC2},
+		{"iso 27001", FrameworkTypeISO27001},
+		{"PCI_DSS", FrameworkTypePCIDSS},
+		{"PCI DSS", FrameworkTypePCIDSS},
+		{" gdpr ", FrameworkTypeGDPR},
+	}
+	for _, tt := range tests {
+		if got, err := ParseFrameworkType(tt.in); err != nil || got != tt.want {
+			t.Errorf("ParseFrameworkType(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+
+	if _, err := ParseFrameworkType("SOX"); !hasCode(err, "INVALID_FRAMEWORK_TYPE") {
+		t.Errorf("ParseFrameworkType(SOX) error = %v, want INVALID_FRAMEWORK_TYPE", err)
+	}
+}
+
+func TestFrameworkVersionBumpPolicy(t *testing.T) {
+	active := activeTestFramework(t, "fw-1", "ctrl-1") // version 1.0.0
+
+	tests := []struct {
+		name       string
+		version    string
+		policy     MajorVersionPolicy
+		wantStatus FrameworkStatus
+		wantCode   string
+	}{
+		{"patch bump", "1.0.1", MajorVersionRevertToDraft, FrameworkStatusActive, ""},
+		{"minor bump", "1.1", MajorVersionReject, FrameworkStatusActive, ""},
+		{"major bump reverts", "2.0.0", MajorVersionRevertToDraft, FrameworkStatusDraft, ""},
+		{"major bump rejected", "2.0.0", MajorVersionReject, "", "MAJOR_VERSION_REQUIRES_REAPPROVAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := active.WithVersionPolicy(tt.version, tt.policy)
+			if tt.wantCode != "" {
+				if !hasCode(err, tt.wantCode) {
+					t.Errorf("WithVersionPolicy() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WithVersionPolicy() error = %v", err)
+			}
+			if got.Version() != tt.version || got.Status() != tt.wantStatus {
+				t.Errorf("WithVersionPolicy() = %s/%s, want %s/%s", got.Version(), got.Status(), tt.version, tt.wantStatus)
+			}
+		})
+	}
+
+	var transitions []string
+	previous := SetTransitionObserver(TransitionObserverFunc(func(entity EntityRef, from, to string, err error) {
+		transitions = append(transitions, entity.ID+": "+from+" -> "+to)
+	}))
+	t.Cleanup(func() { SetTransitionObserver(previous) })
+	if _, err := active.WithVersion("2.0.0"); err != nil {
+		t.Fatalf("WithVersion() error = %v", err)
+	}
+	if want := []string{"fw-1: Active -> Draft"}; !slices.Equal(transitions, want) {
+		t.Errorf("observer saw %v, want %v", transitions, want)
+	}
+
+	draft, err := newTestFramework(t, "fw-2", "ctrl-1").WithVersionPolicy("2.0.0", MajorVersionReject)
+	if err != nil || draft.Status() != FrameworkStatusDraft {
+		t.Errorf("major bump of a Draft framework = %v, %v, want Draft without error", draft, err)
+	}
+}
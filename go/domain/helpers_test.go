@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// testNow is the fixed "current time" used throughout the tests.
+var testNow = time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+
+var testClock = shared.FixedClock{Time: testNow}
+
+// newTestRisk creates an Identified risk at testNow with the given inherent levels.
+func newTestRisk(t *testing.T, id string, likelihood, impact RiskLevel) *Risk {
+	t.Helper()
+	r, err := NewRiskAt(testClock, CreateRiskInput{
+		ID:         id,
+		Title:      "Risk " + id,
+		Category:   RiskCategoryTechnical,
+		Likelihood: likelihood,
+		Impact:     impact,
+		OwnerID:    "user-1",
+	})
+	if err != nil {
+		t.Fatalf("NewRiskAt(%s) error = %v", id, err)
+	}
+	return r
+}
+
+// transitionRisk applies each status in turn, failing the test on the first error.
+func transitionRisk(t *testing.T, r *Risk, statuses ...RiskStatus) *Risk {
+	t.Helper()
+	for _, s := range statuses {
+		var err error
+		if r, err = r.WithStatusAt(testClock, s); err != nil {
+			t.Fatalf("WithStatusAt(%s) error = %v", s, err)
+		}
+	}
+	return r
+}
+
+// mitigatedTestRisk creates a risk mitigated by the given controls, with its
+// residual score lowered to residualLikelihood × residualImpact.
+func mitigatedTestRisk(
+	t *testing.T,
+	id string,
+	likelihood, impact, residualLikelihood, residualImpact RiskLevel,
+	controls ...shared.ControlID,
+) *Risk {
+	t.Helper()
+	r := newTestRisk(t, id, likelihood, impact)
+	r, err := r.WithResidualScore(residualLikelihood, residualImpact)
+	if err != nil {
+		t.Fatalf("WithResidualScore() error = %v", err)
+	}
+	return transitionRisk(t, r,
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"},
+		Mitigated{MitigatedAt: testNow, ControlIDs: controls},
+	)
+}
+
+// newTestControl creates a control at testNow and moves it through the given statuses.
+func newTestControl(t *testing.T, id string, statuses ...ControlStatus) *Control {
+	t.Helper()
+	c, err := NewControlAt(testClock, CreateControlInput{
+		ID:      id,
+		Code:    "CODE-" + id,
+		Title:   "Control " + id,
+		OwnerID: "user-1",
+	})
+	if err != nil {
+		t.Fatalf("NewControlAt(%s) error = %v", id, err)
+	}
+	for _, s := range statuses {
+		if c, err = c.WithStatusAt(testClock, s); err != nil {
+			t.Fatalf("WithStatusAt(%s) error = %v", s, err)
+		}
+	}
+	return c
+}
+
+// newTestEvidence creates a manual review for the control, collected at
+// collectedAt and expiring at expiresAt (nil for never). It is created as of
+// collectedAt so that already-expired evidence can be built.
+func newTestEvidence(t *testing.T, id string, controlID shared.ControlID, collectedAt time.Time, expiresAt *time.Time) *Evidence {
+	t.Helper()
+	e, err := NewEvidenceAt(shared.FixedClock{Time: collectedAt}, CreateEvidenceInput{
+		ID:           id,
+		ControlID:    controlID,
+		EvidenceType: ManualReview{ReviewerID: "user-1", ReviewedAt: collectedAt, Notes: "reviewed"},
+		CollectedAt:  collectedAt,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("NewEvidenceAt(%s) error = %v", id, err)
+	}
+	return e
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+// newTestFramework creates a Draft SOC 2 framework at testNow containing the given controls.
+func newTestFramework(t *testing.T, id string, controls ...shared.ControlID) *Framework {
+	t.Helper()
+	f, err := NewFrameworkAt(testClock, CreateFrameworkInput{
+		ID:      id,
+		Type:    FrameworkTypeSOC2,
+		Name:    "Framework " + id,
+		Version: "1.0.0",
+	})
+	if err != nil {
+		t.Fatalf("NewFrameworkAt(%s) error = %v", id, err)
+	}
+	return f.WithControls(controls...)
+}
+
+// hasCode reports whether err contains a ValidationError with the given code.
+func hasCode(err error, code string) bool {
+	var errs shared.ValidationErrors
+	if errors.As(err, &errs) {
+		return errs.HasCode(code)
+	}
+	var ve shared.ValidationError
+	return errors.As(err, &ve) && ve.Code == code
+}
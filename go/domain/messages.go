@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// Message codes for the label functions. Register templates for these codes
+// with shared.RegisterMessages to add a language.
+const (
+	MsgControlNotImplemented = "control.status.not_implemented"
+	MsgControlInProgress     = "control.status.in_progress"    // %[1]d progress
+	MsgControlImplemented    = "control.status.implemented"    // %[1]s implemented at
+	MsgControlNotApplicable  = "control.status.not_applicable" // %[1]s reason
+	MsgControlFailed         = "control.status.failed"         // %[1]s reason
+
+	MsgRiskIdentified = "risk.status.identified" // %[1]s identified at
+	MsgRiskAssessed   = "risk.status.assessed"   // %[1]s assessed at
+	MsgRiskMitigated  = "risk.status.mitigated"  // %[1]d control count
+	MsgRiskAccepted   = "risk.status.accepted"   // %[1]s reason, %[2]s expires at
+	MsgRiskClosed     = "risk.status.closed"     // %[1]s resolution
+
+	MsgEvidenceDocument       = "evidence.type.document"        // %[1]s file type
+	MsgEvidenceScreenshot     = "evidence.type.screenshot"      // %[1]s captured at
+	MsgEvidenceAutomatedCheck = "evidence.type.automated_check" // %[1]s check name, %[2]s result
+	MsgEvidenceManualReview   = "evidence.type.manual_review"   // %[1]s reviewed at
+)
+
+// Message code prefixes for enumerated values. The full code is the prefix
+// followed by the value's String form, e.g. "risk.level.High".
+const (
+	MsgRiskLevelPrefix    = "risk.level."
+	MsgRiskCategoryPrefix = "risk.category."
+)
+
+func init() {
+	shared.RegisterMessages(shared.LanguageJapanese, map[string]string{
+		MsgControlNotImplemented: "未実装",
+		MsgControlInProgress:     "実装中 (%[1]d%%)",
+		MsgControlImplemented:    "実装済み (%[1]s)",
+		MsgControlNotApplicable:  "適用外: %[1]s",
+		MsgControlFailed:         "失敗: %[1]s",
+
+		MsgRiskIdentified: "特定済み (%[1]s)",
+		MsgRiskAssessed:   "評価済み (%[1]s)",
+		MsgRiskMitigated:  "軽減済み (%[1]d件の統制)",
+		MsgRiskAccepted:   "受容 (%[1]s, 期限: %[2]s)",
+		MsgRiskClosed:     "クローズ (%[1]s)",
+
+		MsgEvidenceDocument:       "ドキュメント (%[1]s)",
+		MsgEvidenceScreenshot:     "スクリーンショット (%[1]s)",
+		MsgEvidenceAutomatedCheck: "自動チェック: %[1]s (%[2]s)",
+		MsgEvidenceManualReview:   "手動レビュー (%[1]s)",
+
+		MsgRiskLevelPrefix + "Negligible": "無視可能",
+		MsgRiskLevelPrefix + "Low":        "低",
+		MsgRiskLevelPrefix + "Medium":     "中",
+		MsgRiskLevelPrefix + "High":       "高",
+		MsgRiskLevelPrefix + "Critical":   "重大",
+		MsgRiskLevelPrefix + "Unknown":    "不明",
+
+		MsgRiskCategoryPrefix + string(RiskCategoryOperational): "運用",
+		MsgRiskCategoryPrefix + string(RiskCategoryTechnical):   "技術",
+		MsgRiskCategoryPrefix + string(RiskCategoryCompliance):  "コンプライアンス",
+		MsgRiskCategoryPrefix + string(RiskCategoryFinancial):   "財務",
+		MsgRiskCategoryPrefix + string(RiskCategoryOther):       "その他",
+	})
+
+	shared.RegisterMessages(shared.LanguageEnglish, map[string]string{
+		MsgControlNotImplemented: "Not Implemented",
+		MsgControlInProgress:     "In Progress (%[1]d%%)",
+		MsgControlImplemented:    "Implemented (%[1]s)",
+		MsgControlNotApplicable:  "Not Applicable: %[1]s",
+		MsgControlFailed:         "Failed: %[1]s",
+
+		MsgRiskIdentified: "Identified (%[1]s)",
+		MsgRiskAssessed:   "Assessed (%[1]s)",
+		MsgRiskMitigated:  "Mitigated (%[1]d controls)",
+		MsgRiskAccepted:   "Accepted (%[1]s, expires: %[2]s)",
+		MsgRiskClosed:     "Closed (%[1]s)",
+
+		MsgEvidenceDocument:       "Document (%[1]s)",
+		MsgEvidenceScreenshot:     "Screenshot (%[1]s)",
+		MsgEvidenceAutomatedCheck: "Automated Check: %[1]s (%[2]s)",
+		MsgEvidenceManualReview:   "Manual Review (%[1]s)",
+
+		MsgRiskLevelPrefix + "Negligible": "Negligible",
+		MsgRiskLevelPrefix + "Low":        "Low",
+		MsgRiskLevelPrefix + "Medium":     "Medium",
+		MsgRiskLevelPrefix + "High":       "High",
+		MsgRiskLevelPrefix + "Critical":   "Critical",
+		MsgRiskLevelPrefix + "Unknown":    "Unknown",
+
+		MsgRiskCategoryPrefix + string(RiskCategoryOperational): "Operational",
+		MsgRiskCategoryPrefix + string(RiskCategoryTechnical):   "Technical",
+		MsgRiskCategoryPrefix + string(RiskCategoryCompliance):  "Compliance",
+		MsgRiskCategoryPrefix + string(RiskCategoryFinancial):   "Financial",
+		MsgRiskCategoryPrefix + string(RiskCategoryOther):       "Other",
+	})
+}
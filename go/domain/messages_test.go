@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestRegisterMessagesForFakeLocale(t *testing.T) {
+	const pseudo shared.Language = "x-pseudo"
+	shared.RegisterMessages(pseudo, map[string]string{
+		MsgRiskIdentified: "[identified %[1]s]",
+		MsgRiskAssessed:   "[assessed %[1]s]",
+		MsgRiskMitigated:  "[mitigated by %[1]d]",
+		MsgRiskAccepted:   "[accepted until %[2]s: %[1]s]",
+		MsgRiskClosed:     "[closed: %[1]s]",
+
+		MsgControlNotImplemented: "[not implemented]",
+		MsgControlInProgress:     "[in progress %[1]d%%]",
+		MsgControlImplemented:    "[implemented %[1]s]",
+		MsgControlNotApplicable:  "[n/a: %[1]s]",
+		// MsgControlFailed is left out to exercise the fallback to ja.
+	})
+
+	progress, err := shared.NewPercentage(40)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+
+	riskTests := []struct {
+		status RiskStatus
+		want   string
+	}{
+		{Identified{IdentifiedAt: testNow}, "[identified 2024-04-01T09:00:00Z]"},
+		{Assessed{AssessedAt: testNow, AssessorID: "user-1"}, "[assessed 2024-04-01T09:00:00Z]"},
+		{Mitigated{MitigatedAt: testNow, ControlIDs: []shared.ControlID{"ctrl-1", "ctrl-2"}}, "[mitigated by 2]"},
+		{Accepted{AcceptedByID: "user-1", Reason: "low impact", ExpiresAt: testNow}, "[accepted until 2024-04-01T09:00:00Z: low impact]"},
+		{Closed{ClosedAt: testNow, Resolution: "fixed"}, "[closed: fixed]"},
+	}
+	for _, tt := range riskTests {
+		if got := GetRiskStatusLabelIn(tt.status, pseudo); got != tt.want {
+			t.Errorf("GetRiskStatusLabelIn(%T) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+
+	controlTests := []struct {
+		status ControlStatus
+		want   string
+	}{
+		{NotImplemented{}, "[not implemented]"},
+		{InProgress{Progress: progress}, "[in progress 40%]"},
+		{Implemented{ImplementedAt: testNow}, "[implemented 2024-04-01T09:00:00Z]"},
+		{NotApplicable{Reason: "no cloud"}, "[n/a: no cloud]"},
+		{Failed{Reason: "audit finding", DetectedAt: testNow}, "失敗: audit finding"},
+	}
+	for _, tt := range controlTests {
+		if got := GetControlStatusLabelIn(tt.status, pseudo); got != tt.want {
+			t.Errorf("GetControlStatusLabelIn(%T) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestStatusLabelsDefaultToJapanese(t *testing.T) {
+	if got, want := GetRiskStatusLabel(Closed{ClosedAt: testNow, Resolution: "fixed"}), "クローズ (fixed)"; got != want {
+		t.Errorf("GetRiskStatusLabel() = %q, want %q", got, want)
+	}
+	if got, want := GetControlStatusLabelIn(NotImplemented{}, shared.LanguageEnglish), "Not Implemented"; got != want {
+		t.Errorf("GetControlStatusLabelIn(en) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+// EntityRef identifies the entity whose status changed.
+type EntityRef struct {
+	Type string // "Control", "Risk" or "Framework"
+	ID   string
+}
+
+// TransitionObserver is notified of every status transition attempted
+// through WithStatus, whether it succeeded or was rejected. It lets callers
+// instrument transitions (e.g. structured logging) without the domain
+// depending on a logger.
+type TransitionObserver interface {
+	// OnTransition receives the status kinds before and after the attempted
+	// transition, and the error if it was rejected. to is empty for a nil status.
+	OnTransition(entity EntityRef, from, to string, err error)
+}
+
+// TransitionObserverFunc adapts a function to a TransitionObserver.
+type TransitionObserverFunc func(entity EntityRef, from, to string, err error)
+
+// OnTransition calls f.
+func (f TransitionObserverFunc) OnTransition(entity EntityRef, from, to string, err error) {
+	f(entity, from, to, err)
+}
+
+type noopObserver struct{}
+
+func (noopObserver) OnTransition(EntityRef, string, string, error) {}
+
+var (
+	transitionObserverMu sync.RWMutex
+	transitionObserver   TransitionObserver = noopObserver{}
+)
+
+// SetTransitionObserver registers the package-wide observer, returning the
+// previous one so that tests can restore it. A nil observer disables
+// notifications.
+func SetTransitionObserver(o TransitionObserver) TransitionObserver {
+	if o == nil {
+		o = noopObserver{}
+	}
+	transitionObserverMu.Lock()
+	defer transitionObserverMu.Unlock()
+	previous := transitionObserver
+	transitionObserver = o
+	return previous
+}
+
+// observerKey is the context key for a per-call TransitionObserver.
+type observerKey struct{}
+
+// WithTransitionObserver returns a copy of ctx carrying o. The WithStatusCtx
+// methods report to it instead of the package-wide observer.
+func WithTransitionObserver(ctx context.Context, o TransitionObserver) context.Context {
+	return context.WithValue(ctx, observerKey{}, o)
+}
+
+// ObserverFromContext returns the TransitionObserver carried by ctx, if any.
+func ObserverFromContext(ctx context.Context) (TransitionObserver, bool) {
+	o, ok := ctx.Value(observerKey{}).(TransitionObserver)
+	return o, ok && o != nil
+}
+
+// notifyTransition reports a transition attempt to the observer carried by
+// ctx, or else to the registered observer.
+func notifyTransition(ctx context.Context, entity EntityRef, from, to string, err error) {
+	if o, ok := ObserverFromContext(ctx); ok {
+		o.OnTransition(entity, from, to, err)
+		return
+	}
+	transitionObserverMu.RLock()
+	o := transitionObserver
+	transitionObserverMu.RUnlock()
+	o.OnTransition(entity, from, to, err)
+}
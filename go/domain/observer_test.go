@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+// transitionRecord is one call captured by spyObserver.
+type transitionRecord struct {
+	entity   EntityRef
+	from, to string
+	rejected bool
+}
+
+// spyObserver records every transition it is notified of.
+type spyObserver struct {
+	records []transitionRecord
+}
+
+func (s *spyObserver) OnTransition(entity EntityRef, from, to string, err error) {
+	s.records = append(s.records, transitionRecord{entity, from, to, err != nil})
+}
+
+func TestPackageTransitionObserver(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+
+	spy := &spyObserver{}
+	previous := SetTransitionObserver(spy)
+	t.Cleanup(func() { SetTransitionObserver(previous) })
+
+	if _, err := r.WithStatusAt(testClock, Closed{ClosedAt: testNow, Resolution: "n/a"}); err == nil {
+		t.Fatal("WithStatusAt(Closed) error = nil, want Identified -> Closed rejected")
+	}
+	if _, err := r.WithStatusAt(testClock, Assessed{AssessedAt: testNow, AssessorID: "user-1"}); err != nil {
+		t.Fatalf("WithStatusAt(Assessed) error = %v", err)
+	}
+
+	risk := EntityRef{Type: "Risk", ID: "risk-1"}
+	want := []transitionRecord{
+		{risk, RiskStatusIdentified, RiskStatusClosed, true},
+		{risk, RiskStatusIdentified, RiskStatusAssessed, false},
+	}
+	if len(spy.records) != len(want) {
+		t.Fatalf("observer saw %+v, want %+v", spy.records, want)
+	}
+	for i := range want {
+		if spy.records[i] != want[i] {
+			t.Errorf("records[%d] = %+v, want %+v", i, spy.records[i], want[i])
+		}
+	}
+}
+
+func TestContextTransitionObserver(t *testing.T) {
+	global := &spyObserver{}
+	previous := SetTransitionObserver(global)
+	t.Cleanup(func() { SetTransitionObserver(previous) })
+
+	spy := &spyObserver{}
+	ctx := WithTransitionObserver(context.Background(), spy)
+
+	empty := newTestFramework(t, "fw-1")
+	if _, err := empty.WithStatusCtx(ctx, testClock, FrameworkStatusActive); err == nil {
+		t.Fatal("WithStatusCtx(Active) error = nil, want NO_CONTROLS")
+	}
+	if _, err := newTestFramework(t, "fw-2", "ctrl-1").WithStatusCtx(ctx, testClock, FrameworkStatusActive); err != nil {
+		t.Fatalf("WithStatusCtx(Active) error = %v", err)
+	}
+
+	want := []transitionRecord{
+		{EntityRef{Type: "Framework", ID: "fw-1"}, "Draft", "Active", true},
+		{EntityRef{Type: "Framework", ID: "fw-2"}, "Draft", "Active", false},
+	}
+	if len(spy.records) != len(want) || spy.records[0] != want[0] || spy.records[1] != want[1] {
+		t.Errorf("context observer saw %+v, want %+v", spy.records, want)
+	}
+	if len(global.records) != 0 {
+		t.Errorf("package observer saw %+v, want nothing while a context observer is set", global.records)
+	}
+}
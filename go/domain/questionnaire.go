@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// Question is a weighted questionnaire item answered on the five-point
+// RiskLevel scale. Answered must be set, since Negligible is the zero Answer.
+type Question struct {
+	Text     string
+	Weight   float64
+	Answer   RiskLevel
+	Answered bool
+}
+
+// Questionnaire derives a RiskLevel from weighted answers.
+type Questionnaire struct {
+	Questions []Question
+}
+
+// DeriveRiskLevel maps the weighted average of the answers onto the RiskLevel
+// scale, rounding to the nearest level.
+// Every question must be answered and the weights must sum to a positive value.
+func (q Questionnaire) DeriveRiskLevel() (RiskLevel, error) {
+	var errors shared.ValidationErrors
+
+	if len(q.Questions) == 0 {
+		errors.Add("questions", "Questionnaire has no questions", "INCOMPLETE_QUESTIONNAIRE")
+	}
+
+	var totalWeight, weightedSum float64
+	for i, question := range q.Questions {
+		field := fmt.Sprintf("questions[%d]", i)
+		if question.Weight < 0 {
+			errors.Add(field, "Question weight cannot be negative", "INVALID_WEIGHT")
+		}
+		if !question.Answered {
+			errors.Add(field, "Question is not answered", "INCOMPLETE_QUESTIONNAIRE")
+		} else if question.Answer < RiskLevelNegligible || question.Answer > RiskLevelCritical {
+			errors.Add(field, "Answer is outside the risk level scale", "INVALID_RISK_LEVEL")
+		}
+		totalWeight += question.Weight
+		weightedSum += question.Weight * float64(question.Answer)
+	}
+
+	if len(q.Questions) > 0 && totalWeight <= 0 {
+		errors.Add("questions", "Question weights must sum to a positive value", "INVALID_WEIGHT")
+	}
+
+	if errors.HasErrors() {
+		return 0, errors
+	}
+
+	return RiskLevel(math.Round(weightedSum / totalWeight)), nil
+}
+
+// NewRiskFromQuestionnaire creates a Risk whose likelihood and impact are
+// derived from questionnaires. Likelihood and Impact in input are ignored.
+func NewRiskFromQuestionnaire(likelihoodQ, impactQ Questionnaire, input CreateRiskInput) (*Risk, error) {
+	var errors shared.ValidationErrors
+
+	likelihood, err := likelihoodQ.DeriveRiskLevel()
+	if err != nil {
+		addPrefixed(&errors, "likelihood", err)
+	}
+
+	impact, err := impactQ.DeriveRiskLevel()
+	if err != nil {
+		addPrefixed(&errors, "impact", err)
+	}
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	input.Likelihood = likelihood
+	input.Impact = impact
+	return NewRisk(input)
+}
+
+// addPrefixed appends err to errors with each field prefixed by prefix.
+func addPrefixed(errors *shared.ValidationErrors, prefix string, err error) {
+	var nested shared.ValidationErrors
+	nested.AddError("", err)
+	for _, ve := range nested {
+		field := prefix
+		if ve.Field != "" {
+			field += "." + ve.Field
+		}
+		ve.Field = field
+		*errors = append(*errors, ve)
+	}
+}
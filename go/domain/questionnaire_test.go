@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestQuestionnaireDeriveRiskLevel(t *testing.T) {
+	q := Questionnaire{Questions: []Question{
+		{Text: "Is the system internet-facing?", Weight: 2, Answer: RiskLevelCritical, Answered: true},
+		{Text: "Has it been exploited before?", Weight: 1, Answer: RiskLevelLow, Answered: true},
+		{Text: "Are patches applied monthly?", Weight: 1, Answer: RiskLevelMedium, Answered: true},
+	}}
+
+	// (2×4 + 1×1 + 1×2) / 4 = 2.75, rounded to High
+	got, err := q.DeriveRiskLevel()
+	if err != nil {
+		t.Fatalf("DeriveRiskLevel() error = %v", err)
+	}
+	if got != RiskLevelHigh {
+		t.Errorf("DeriveRiskLevel() = %s, want High", got)
+	}
+}
+
+func TestQuestionnaireDerivesNegligible(t *testing.T) {
+	q := Questionnaire{Questions: []Question{
+		{Text: "Is the data public?", Weight: 3, Answer: RiskLevelNegligible, Answered: true},
+		{Text: "Is it regulated?", Weight: 1, Answer: RiskLevelLow, Answered: true},
+	}}
+
+	// (3×0 + 1×1) / 4 = 0.25, rounded to Negligible
+	got, err := q.DeriveRiskLevel()
+	if err != nil {
+		t.Fatalf("DeriveRiskLevel() error = %v", err)
+	}
+	if got != RiskLevelNegligible {
+		t.Errorf("DeriveRiskLevel() = %s, want Negligible", got)
+	}
+}
+
+func TestQuestionnaireRejectsUnanswered(t *testing.T) {
+	q := Questionnaire{Questions: []Question{
+		{Text: "Answered", Weight: 1, Answer: RiskLevelLow, Answered: true},
+		{Text: "Unanswered", Weight: 1},
+	}}
+
+	_, err := q.DeriveRiskLevel()
+
+	var errs shared.ValidationErrors
+	if !errors.As(err, &errs) || len(errs.FieldErrors("questions[1]")) != 1 {
+		t.Fatalf("DeriveRiskLevel() error = %v, want INCOMPLETE_QUESTIONNAIRE on questions[1]", err)
+	}
+}
+
+func TestNewRiskFromQuestionnaire(t *testing.T) {
+	likelihood := Questionnaire{Questions: []Question{{Text: "Frequency", Weight: 1, Answer: RiskLevelMedium, Answered: true}}}
+	impact := Questionnaire{Questions: []Question{{Text: "Severity", Weight: 1, Answer: RiskLevelHigh, Answered: true}}}
+
+	r, err := NewRiskFromQuestionnaire(likelihood, impact, CreateRiskInput{
+		ID:       "risk-1",
+		Title:    "Data leak",
+		Category: RiskCategoryTechnical,
+	})
+	if err != nil {
+		t.Fatalf("NewRiskFromQuestionnaire() error = %v", err)
+	}
+	if s := r.InherentScore(); s.Likelihood() != RiskLevelMedium || s.Impact() != RiskLevelHigh {
+		t.Errorf("inherent = %s×%s, want Medium×High", s.Likelihood(), s.Impact())
+	}
+
+	_, err = NewRiskFromQuestionnaire(Questionnaire{}, impact, CreateRiskInput{ID: "risk-2", Title: "Empty"})
+	var errs shared.ValidationErrors
+	if !errors.As(err, &errs) || len(errs.FieldErrors("likelihood.questions")) != 1 {
+		t.Errorf("NewRiskFromQuestionnaire() with no likelihood questions error = %v", err)
+	}
+}
@@ -1,7 +1,13 @@
 package domain
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/example/grc-domain-models/domain/shared"
@@ -10,15 +16,25 @@ import (
 // RiskLevel represents the severity level of a risk.
 type RiskLevel int
 
+// Low through Critical keep their original values 1 to 4, which are persisted
+// and multiplied into RiskScore values.
+//
+// RiskLevelNegligible is the bottom of the optional five-point scale. It is
+// the zero value, so it is only accepted by scoring policies that opt into
+// that scale (see RiskLevelScale); everywhere else an unset likelihood or
+// impact is rejected.
 const (
-	RiskLevelLow RiskLevel = iota + 1
-	RiskLevelMedium
-	RiskLevelHigh
-	RiskLevelCritical
+	RiskLevelNegligible RiskLevel = 0
+	RiskLevelLow        RiskLevel = 1
+	RiskLevelMedium     RiskLevel = 2
+	RiskLevelHigh       RiskLevel = 3
+	RiskLevelCritical   RiskLevel = 4
 )
 
 func (l RiskLevel) String() string {
 	switch l {
+	case RiskLevelNegligible:
+		return "Negligible"
 	case RiskLevelLow:
 		return "Low"
 	case RiskLevelMedium:
@@ -32,34 +48,254 @@ func (l RiskLevel) String() string {
 	}
 }
 
+// ParseRiskLevel parses a level from its String form, case-insensitively,
+// or from its numeric value ("1" for Low through "4" for Critical).
+// "Negligible" is accepted by name only, for five-point scales.
+func ParseRiskLevel(s string) (RiskLevel, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		if level := RiskLevel(n); level.IsValid() {
+			return level, nil
+		}
+	} else {
+		for level := RiskLevelNegligible; level <= RiskLevelCritical; level++ {
+			if strings.EqualFold(s, level.String()) {
+				return level, nil
+			}
+		}
+	}
+	return 0, shared.NewValidationError(
+		"riskLevel",
+		fmt.Sprintf("Unknown risk level: %q", s),
+		"INVALID_RISK_LEVEL",
+	)
+}
+
+// Label returns the localized display name of the level.
+// String remains the stable English form.
+func (l RiskLevel) Label(lang shared.Language) string {
+	return shared.DefaultCatalog.Format(lang, MsgRiskLevelPrefix+l.String())
+}
+
 // RiskScore is an immutable value object representing a risk score.
 type RiskScore struct {
 	likelihood RiskLevel
 	impact     RiskLevel
 	value      int
 	label      string
+	// minValue and maxValue are the lowest and highest values the scoring
+	// policy produces, used to normalize scores across matrices.
+	minValue int
+	maxValue int
+	// level is the score's band on the RiskLevel scale.
+	level RiskLevel
 }
 
-// CalculateRiskScore creates a new RiskScore from likelihood and impact.
-func CalculateRiskScore(likelihood, impact RiskLevel) RiskScore {
-	value := int(likelihood) * int(impact)
-	var label string
-	switch {
-	case value <= 2:
-		label = "Low"
-	case value <= 6:
-		label = "Medium"
-	case value <= 12:
-		label = "High"
-	default:
-		label = "Critical"
+// IsValid returns true if the level is on the default scale, from
+// RiskLevelLow to RiskLevelCritical.
+func (l RiskLevel) IsValid() bool {
+	return l >= RiskLevelLow && l <= RiskLevelCritical
+}
+
+// RiskLevelScale is implemented by scoring policies whose levels differ from
+// the default [RiskLevelLow, RiskLevelCritical] range.
+type RiskLevelScale interface {
+	LevelRange() (lowest, highest RiskLevel)
+}
+
+// levelRange returns the levels accepted by policy.
+func levelRange(policy RiskScoringPolicy) (lowest, highest RiskLevel) {
+	if scale, ok := policy.(RiskLevelScale); ok {
+		return scale.LevelRange()
 	}
-	return RiskScore{
+	return RiskLevelLow, RiskLevelCritical
+}
+
+// isValidFor returns true if l is within the levels accepted by policy.
+func (l RiskLevel) isValidFor(policy RiskScoringPolicy) bool {
+	lowest, highest := levelRange(policy)
+	return l >= lowest && l <= highest
+}
+
+// Compare returns -1, 0 or 1 depending on whether l is lower than,
+// equal to, or higher than other.
+func (l RiskLevel) Compare(other RiskLevel) int {
+	return cmp.Compare(l, other)
+}
+
+// RiskScoringPolicy computes the numeric value and label of a risk score
+// from likelihood and impact. Implement it to plug in a custom matrix.
+type RiskScoringPolicy interface {
+	Evaluate(likelihood, impact RiskLevel) (value int, label string)
+}
+
+// CalculateRiskScoreWith creates a new RiskScore using the given policy.
+func CalculateRiskScoreWith(policy RiskScoringPolicy, likelihood, impact RiskLevel) RiskScore {
+	value, label := policy.Evaluate(likelihood, impact)
+	lowest, highest := levelRange(policy)
+	minValue, _ := policy.Evaluate(lowest, lowest)
+	maxValue, _ := policy.Evaluate(highest, highest)
+	score := RiskScore{
 		likelihood: likelihood,
 		impact:     impact,
 		value:      value,
 		label:      label,
+		minValue:   minValue,
+		maxValue:   maxValue,
+	}
+	score.level = scoreLevel(policy, score)
+	return score
+}
+
+// scoreLevel maps a score onto the RiskLevel scale: by its label if that
+// names a level, by band position for a RiskMatrix, and otherwise by where
+// its value lies between Low and Critical.
+func scoreLevel(policy RiskScoringPolicy, score RiskScore) RiskLevel {
+	if m, ok := policy.(RiskMatrix); ok {
+		return m.predictedLevel(score.likelihood, score.impact)
 	}
+	for l := RiskLevelNegligible; l <= RiskLevelCritical; l++ {
+		if l.String() == score.label {
+			return l
+		}
+	}
+	span := float64(RiskLevelCritical - RiskLevelLow)
+	return RiskLevelLow + RiskLevel(math.Round(score.Percentile()*span/100))
+}
+
+// CalculateRiskScore creates a new RiskScore from likelihood and impact
+// using the default matrix.
+func CalculateRiskScore(likelihood, impact RiskLevel) RiskScore {
+	return CalculateRiskScoreWith(DefaultRiskMatrix(), likelihood, impact)
+}
+
+// RiskBand assigns a label to score values up to and including Max.
+type RiskBand struct {
+	Max   int
+	Label string
+}
+
+// RiskMatrix is a RiskScoringPolicy that combines likelihood and impact
+// (by product, or by sum for additive matrices) and labels the value by band.
+// Bands are ordered by ascending Max; values above the last band's Max
+// take the last band's label.
+type RiskMatrix struct {
+	bands     []RiskBand
+	additive  bool
+	fivePoint bool
+}
+
+// NewRiskMatrix creates a validated multiplicative RiskMatrix from bands in ascending order.
+func NewRiskMatrix(bands ...RiskBand) (RiskMatrix, error) {
+	return newRiskMatrix(false, bands)
+}
+
+// NewAdditiveRiskMatrix creates a validated RiskMatrix that scores
+// likelihood + impact instead of likelihood × impact.
+func NewAdditiveRiskMatrix(bands ...RiskBand) (RiskMatrix, error) {
+	return newRiskMatrix(true, bands)
+}
+
+func newRiskMatrix(additive bool, bands []RiskBand) (RiskMatrix, error) {
+	var errors shared.ValidationErrors
+
+	if len(bands) == 0 {
+		errors.Add("bands", "At least one band is required", "REQUIRED")
+	}
+	for i, b := range bands {
+		if b.Label == "" {
+			errors.Add("bands", fmt.Sprintf("Band %d label is required", i), "REQUIRED")
+		}
+		if i > 0 && b.Max <= bands[i-1].Max {
+			errors.Add("bands", "Band maximums must be strictly ascending", "INVALID_BANDS")
+		}
+	}
+
+	if errors.HasErrors() {
+		return RiskMatrix{}, errors
+	}
+
+	copied := make([]RiskBand, len(bands))
+	copy(copied, bands)
+	return RiskMatrix{bands: copied, additive: additive}, nil
+}
+
+// DefaultRiskMatrix returns the standard 4×4 multiplicative matrix:
+// Low (≤2), Medium (≤6), High (≤12), Critical (above 12).
+func DefaultRiskMatrix() RiskMatrix {
+	return RiskMatrix{bands: []RiskBand{
+		{Max: 2, Label: "Low"},
+		{Max: 6, Label: "Medium"},
+		{Max: 12, Label: "High"},
+		{Max: 16, Label: "Critical"},
+	}}
+}
+
+// FivePointRiskMatrix returns a 5×5 multiplicative matrix on the five-point
+// scale from Negligible (1) to Critical (5):
+// Low (≤6), Medium (≤12), High (≤20), Critical (above 20).
+func FivePointRiskMatrix() RiskMatrix {
+	return RiskMatrix{bands: []RiskBand{
+		{Max: 6, Label: "Low"},
+		{Max: 12, Label: "Medium"},
+		{Max: 20, Label: "High"},
+		{Max: 25, Label: "Critical"},
+	}}.WithFivePointScale()
+}
+
+// WithFivePointScale returns a copy of the matrix that accepts
+// RiskLevelNegligible and scores levels on the five-point scale, where
+// Negligible counts as 1 and Critical as 5.
+func (m RiskMatrix) WithFivePointScale() RiskMatrix {
+	m.bands = m.Bands()
+	m.fivePoint = true
+	return m
+}
+
+// LevelRange implements RiskLevelScale.
+func (m RiskMatrix) LevelRange() (lowest, highest RiskLevel) {
+	if m.fivePoint {
+		return RiskLevelNegligible, RiskLevelCritical
+	}
+	return RiskLevelLow, RiskLevelCritical
+}
+
+// Bands returns a copy of the matrix bands.
+func (m RiskMatrix) Bands() []RiskBand {
+	result := make([]RiskBand, len(m.bands))
+	copy(result, m.bands)
+	return result
+}
+
+// Evaluate implements RiskScoringPolicy.
+// The zero RiskMatrix evaluates with the default bands.
+func (m RiskMatrix) Evaluate(likelihood, impact RiskLevel) (int, string) {
+	bands := m.bands
+	if len(bands) == 0 {
+		bands = DefaultRiskMatrix().bands
+	}
+
+	l, i := int(likelihood), int(impact)
+	if m.fivePoint {
+		l, i = l+1, i+1
+	}
+
+	value := l * i
+	if m.additive {
+		value = l + i
+	}
+
+	for _, b := range bands {
+		if value <= b.Max {
+			return value, b.Label
+		}
+	}
+	return value, bands[len(bands)-1].Label
+}
+
+// Score calculates a RiskScore from likelihood and impact using this matrix.
+func (m RiskMatrix) Score(likelihood, impact RiskLevel) RiskScore {
+	return CalculateRiskScoreWith(m, likelihood, impact)
 }
 
 // Getter methods for RiskScore
@@ -68,6 +304,57 @@ func (r RiskScore) Impact() RiskLevel     { return r.impact }
 func (r RiskScore) Value() int            { return r.value }
 func (r RiskScore) Label() string         { return r.label }
 
+// Level returns the band of the score on the RiskLevel scale, independent of
+// the label wording of its scoring policy.
+func (r RiskScore) Level() RiskLevel { return r.level }
+
+// Percentile returns where the value lies between the lowest and highest
+// values of its scoring policy, from 0 to 100. This makes scores from
+// different matrices comparable.
+func (r RiskScore) Percentile() float64 {
+	if r.maxValue <= r.minValue {
+		return 0
+	}
+	return float64(r.value-r.minValue) * 100 / float64(r.maxValue-r.minValue)
+}
+
+// Normalized returns the value mapped onto a 1-100 scale, where 1 is the
+// lowest and 100 the highest value of its scoring policy.
+func (r RiskScore) Normalized() int {
+	return 1 + int(math.Round(r.Percentile()*99/100))
+}
+
+// Equal returns true if both scores have the same likelihood, impact, value and label.
+// Use Compare to treat scores with the same value as equal.
+func (r RiskScore) Equal(other RiskScore) bool {
+	return r.likelihood == other.likelihood &&
+		r.impact == other.impact &&
+		r.value == other.value &&
+		r.label == other.label
+}
+
+// Cell returns the zero-based heat-map indexes of the score, i.e. its
+// likelihood and impact minus one. Both are -1 if either level is outside the
+// default scale, including Negligible.
+func (r RiskScore) Cell() (likelihoodIdx, impactIdx int) {
+	if !r.likelihood.IsValid() || !r.impact.IsValid() {
+		return -1, -1
+	}
+	return int(r.likelihood) - 1, int(r.impact) - 1
+}
+
+// Compare returns -1, 0 or 1 depending on whether r's value is lower than,
+// equal to, or higher than other's. Scores with the same value compare equal
+// regardless of their likelihood and impact.
+func (r RiskScore) Compare(other RiskScore) int {
+	return cmp.Compare(r.value, other.value)
+}
+
+// IsHigherThan returns true if r's value is higher than other's.
+func (r RiskScore) IsHigherThan(other RiskScore) bool {
+	return r.value > other.value
+}
+
 // RiskCategory represents the category of a risk.
 type RiskCategory string
 
@@ -76,8 +363,50 @@ const (
 	RiskCategoryTechnical   RiskCategory = "Technical"
 	RiskCategoryCompliance  RiskCategory = "Compliance"
 	RiskCategoryFinancial   RiskCategory = "Financial"
+	RiskCategoryOther       RiskCategory = "Other"
+)
+
+// riskCategories registers the known categories in display order.
+var riskCategories = shared.NewEnum(
+	func(c RiskCategory) string { return string(c) },
+	RiskCategoryOperational,
+	RiskCategoryTechnical,
+	RiskCategoryCompliance,
+	RiskCategoryFinancial,
+	RiskCategoryOther,
 )
 
+// AllRiskCategories returns every known category in display order.
+func AllRiskCategories() []RiskCategory {
+	return riskCategories.Values()
+}
+
+// ParseRiskCategory parses a category from its value, case-insensitively.
+func ParseRiskCategory(s string) (RiskCategory, error) {
+	if c, ok := riskCategories.Parse(s); ok {
+		return c, nil
+	}
+	return "", shared.NewValidationError(
+		"category",
+		fmt.Sprintf("Unknown risk category: %q", s),
+		"INVALID_CATEGORY",
+	)
+}
+
+// IsValid reports whether c is one of the known categories.
+func (c RiskCategory) IsValid() bool {
+	return riskCategories.IsValid(c)
+}
+
+// Label returns the localized display name of the category.
+// Categories without a registered message fall back to the raw value.
+func (c RiskCategory) Label(lang shared.Language) string {
+	if _, ok := shared.DefaultCatalog.Template(MsgRiskCategoryPrefix+string(c), lang); !ok {
+		return string(c)
+	}
+	return shared.DefaultCatalog.Format(lang, MsgRiskCategoryPrefix+string(c))
+}
+
 // RiskStatus represents the status of a risk.
 // Uses the sealed interface pattern.
 type RiskStatus interface {
@@ -114,6 +443,12 @@ func (s Mitigated) String() string {
 	return fmt.Sprintf("Mitigated (%d controls)", len(s.ControlIDs))
 }
 
+// Clone returns a copy of the status with its own ControlIDs slice.
+func (s Mitigated) Clone() Mitigated {
+	s.ControlIDs = slices.Clone(s.ControlIDs)
+	return s
+}
+
 type Accepted struct {
 	AcceptedByID shared.UserID
 	Reason       string
@@ -128,10 +463,14 @@ func (s Accepted) String() string {
 type Closed struct {
 	ClosedAt   time.Time
 	Resolution string
+	Forced     bool // closed despite a High or Critical residual score
 }
 
 func (Closed) riskStatus() {}
 func (s Closed) String() string {
+	if s.Forced {
+		return fmt.Sprintf("Closed: %s (forced)", s.Resolution)
+	}
 	return fmt.Sprintf("Closed: %s", s.Resolution)
 }
 
@@ -143,6 +482,30 @@ func MatchRiskStatus[T any](
 	onMitigated func(time.Time, []shared.ControlID) T,
 	onAccepted func(shared.UserID, string, time.Time) T,
 	onClosed func(time.Time, string) T,
+) T {
+	return MatchRiskStatusOr(
+		status,
+		func(s RiskStatus) T {
+			if s == nil {
+				panic("nil RiskStatus passed to MatchRiskStatus")
+			}
+			panic(fmt.Sprintf("unknown RiskStatus: %T", s))
+		},
+		onIdentified, onAssessed, onMitigated, onAccepted, onClosed,
+	)
+}
+
+// MatchRiskStatusOr is like MatchRiskStatus but calls fallback instead of
+// panicking when the status is not a known variant, e.g. one introduced
+// by a newer version during a rolling deploy.
+func MatchRiskStatusOr[T any](
+	status RiskStatus,
+	fallback func(RiskStatus) T,
+	onIdentified func(time.Time) T,
+	onAssessed func(time.Time, shared.UserID) T,
+	onMitigated func(time.Time, []shared.ControlID) T,
+	onAccepted func(shared.UserID, string, time.Time) T,
+	onClosed func(time.Time, string) T,
 ) T {
 	switch s := status.(type) {
 	case Identified:
@@ -156,20 +519,127 @@ func MatchRiskStatus[T any](
 	case Closed:
 		return onClosed(s.ClosedAt, s.Resolution)
 	default:
-		panic(fmt.Sprintf("unknown RiskStatus: %T", status))
+		return fallback(status)
+	}
+}
+
+// IsClosedRisk returns true if the risk is Closed.
+func IsClosedRisk(s RiskStatus) bool {
+	return MatchRiskStatus(
+		s,
+		func(time.Time) bool { return false },
+		func(time.Time, shared.UserID) bool { return false },
+		func(time.Time, []shared.ControlID) bool { return false },
+		func(shared.UserID, string, time.Time) bool { return false },
+		func(time.Time, string) bool { return true },
+	)
+}
+
+// IsAcceptanceExpired returns true if the status is Accepted and now is
+// after its ExpiresAt. At exactly ExpiresAt the acceptance is still in effect.
+func IsAcceptanceExpired(s RiskStatus, now time.Time) bool {
+	return MatchRiskStatus(
+		s,
+		func(time.Time) bool { return false },
+		func(time.Time, shared.UserID) bool { return false },
+		func(time.Time, []shared.ControlID) bool { return false },
+		func(_ shared.UserID, _ string, expiresAt time.Time) bool { return now.After(expiresAt) },
+		func(time.Time, string) bool { return false },
+	)
+}
+
+// IsOpenRisk returns true if the risk still needs attention as of now:
+// every status except Closed and an Accepted status that has not expired.
+func IsOpenRisk(s RiskStatus) bool {
+	return IsOpenRiskAt(s, time.Now())
+}
+
+// IsOpenRiskAt is like IsOpenRisk but evaluates acceptance expiry at the given time.
+func IsOpenRiskAt(s RiskStatus, now time.Time) bool {
+	return MatchRiskStatus(
+		s,
+		func(time.Time) bool { return true },
+		func(time.Time, shared.UserID) bool { return true },
+		func(time.Time, []shared.ControlID) bool { return true },
+		func(shared.UserID, string, time.Time) bool { return IsAcceptanceExpired(s, now) },
+		func(time.Time, string) bool { return false },
+	)
+}
+
+// RiskStatusEqual returns true if both statuses are the same variant with equal data.
+func RiskStatusEqual(a, b RiskStatus) bool {
+	switch x := a.(type) {
+	case Identified:
+		y, ok := b.(Identified)
+		return ok && x.IdentifiedAt.Equal(y.IdentifiedAt)
+	case Assessed:
+		y, ok := b.(Assessed)
+		return ok && x.AssessedAt.Equal(y.AssessedAt) && x.AssessorID == y.AssessorID
+	case Mitigated:
+		y, ok := b.(Mitigated)
+		return ok && x.MitigatedAt.Equal(y.MitigatedAt) && slices.Equal(x.ControlIDs, y.ControlIDs)
+	case Accepted:
+		y, ok := b.(Accepted)
+		return ok && x.AcceptedByID == y.AcceptedByID && x.Reason == y.Reason && x.ExpiresAt.Equal(y.ExpiresAt)
+	case Closed:
+		y, ok := b.(Closed)
+		return ok && x.ClosedAt.Equal(y.ClosedAt) && x.Resolution == y.Resolution && x.Forced == y.Forced
+	default:
+		return a == nil && b == nil
 	}
 }
 
+// Risk status kinds used as states in the risk transition table.
+const (
+	RiskStatusIdentified = "Identified"
+	RiskStatusAssessed   = "Assessed"
+	RiskStatusMitigated  = "Mitigated"
+	RiskStatusAccepted   = "Accepted"
+	RiskStatusClosed     = "Closed"
+)
+
+// RiskStatusKind returns the kind of a RiskStatus without its data.
+func RiskStatusKind(status RiskStatus) string {
+	return MatchRiskStatus(
+		status,
+		func(time.Time) string { return RiskStatusIdentified },
+		func(time.Time, shared.UserID) string { return RiskStatusAssessed },
+		func(time.Time, []shared.ControlID) string { return RiskStatusMitigated },
+		func(shared.UserID, string, time.Time) string { return RiskStatusAccepted },
+		func(time.Time, string) string { return RiskStatusClosed },
+	)
+}
+
+// riskTransitions defines the risk lifecycle. Closed is terminal.
+var riskTransitions = shared.NewTransitionTable[string]().
+	Allow(RiskStatusIdentified, RiskStatusAssessed).
+	Allow(RiskStatusAssessed, RiskStatusMitigated, RiskStatusAccepted).
+	Allow(RiskStatusMitigated, RiskStatusClosed, RiskStatusAssessed).
+	Allow(RiskStatusAccepted, RiskStatusAssessed, RiskStatusClosed)
+
+// AllowedRiskTransitions returns the status kinds reachable from the given status.
+func AllowedRiskTransitions(from RiskStatus) []string {
+	return riskTransitions.AllowedFrom(RiskStatusKind(from))
+}
+
 // Risk represents a compliance risk entity.
 type Risk struct {
-	id            shared.RiskID
-	title         string
-	description   string
-	category      RiskCategory
-	inherentScore RiskScore
-	residualScore RiskScore
-	status        RiskStatus
-	ownerID       shared.UserID
+	id             shared.RiskID
+	title          string
+	description    string
+	category       RiskCategory
+	inherentScore  RiskScore
+	residualScore  RiskScore
+	scoring        RiskScoringPolicy
+	status         RiskStatus
+	ownerID        shared.UserID
+	expectedLoss   *shared.Money // nil means not quantified
+	statusSince    time.Time     // when the current status began
+	relatedRiskIDs []shared.RiskID
+	createdBy      shared.UserID // empty if created without an actor
+	clock          shared.Clock  // stamps updatedAt; nil means the system clock
+	createdAt      time.Time
+	updatedAt      time.Time
 }
 
 // Getter methods
@@ -179,8 +649,28 @@ func (r *Risk) Description() string      { return r.description }
 func (r *Risk) Category() RiskCategory   { return r.category }
 func (r *Risk) InherentScore() RiskScore { return r.inherentScore }
 func (r *Risk) ResidualScore() RiskScore { return r.residualScore }
-func (r *Risk) Status() RiskStatus       { return r.status }
+func (r *Risk) Status() RiskStatus       { return cloneRiskStatus(r.status) }
 func (r *Risk) OwnerID() shared.UserID   { return r.ownerID }
+func (r *Risk) Scoring() RiskScoringPolicy {
+	return r.scoring
+}
+func (r *Risk) StatusSince() time.Time {
+	return r.statusSince
+}
+
+// HasStatus reports whether the Risk has a status set. A Risk built by a
+// partial deserialization may not, and matching on its status would panic.
+func (r *Risk) HasStatus() bool { return r.status != nil }
+
+// CreatedBy returns the user who created the risk, or "" if unknown.
+func (r *Risk) CreatedBy() shared.UserID { return r.createdBy }
+func (r *Risk) CreatedAt() time.Time     { return r.createdAt }
+func (r *Risk) UpdatedAt() time.Time     { return r.updatedAt }
+
+// RelatedRiskIDs returns the risks this risk causes or relates to.
+func (r *Risk) RelatedRiskIDs() []shared.RiskID {
+	return slices.Clone(r.relatedRiskIDs)
+}
 
 // CreateRiskInput holds the input for creating a Risk.
 type CreateRiskInput struct {
@@ -191,55 +681,197 @@ type CreateRiskInput struct {
 	Likelihood  RiskLevel
 	Impact      RiskLevel
 	OwnerID     shared.UserID
+	Scoring     RiskScoringPolicy // nil uses DefaultRiskMatrix
 }
 
 // NewRisk creates a new Risk with validation.
 func NewRisk(input CreateRiskInput) (*Risk, error) {
-	var errors shared.ValidationErrors
+	return NewRiskAt(shared.SystemClock{}, input)
+}
+
+// NewRiskAt creates a new Risk identified at the current time of the given clock.
+func NewRiskAt(clock shared.Clock, input CreateRiskInput) (*Risk, error) {
+	return RiskBuilder{input: input, clock: clock}.build(nil)
+}
+
+// NewRiskWithActor is like NewRisk but records the actor carried by ctx
+// (see shared.ContextWithActor) as the risk's creator.
+func NewRiskWithActor(ctx context.Context, input CreateRiskInput) (*Risk, error) {
+	return NewRiskWithActorAt(ctx, shared.SystemClock{}, input)
+}
 
-	id, err := shared.NewRiskID(input.ID)
+// NewRiskWithActorAt is like NewRiskWithActor but identifies the risk at the
+// current time of the given clock.
+func NewRiskWithActorAt(ctx context.Context, clock shared.Clock, input CreateRiskInput) (*Risk, error) {
+	r, err := NewRiskAt(clock, input)
 	if err != nil {
-		if ve, ok := err.(shared.ValidationError); ok {
-			errors = append(errors, ve)
-		}
+		return nil, err
+	}
+	if actor, ok := shared.ActorFromContext(ctx); ok {
+		r.createdBy = actor
+	}
+	return r, nil
+}
+
+// Equal returns true if both risks have the same ID and field values,
+// including status. The scoring policy itself is not compared; the scores it
+// produced are. Creation and modification timestamps are not compared either.
+func (r *Risk) Equal(other *Risk) bool {
+	if r == nil || other == nil {
+		return r == other
 	}
 
-	if input.Title == "" {
+	sameLoss := (r.expectedLoss == nil) == (other.expectedLoss == nil)
+	if sameLoss && r.expectedLoss != nil {
+		sameLoss = r.expectedLoss.Equal(*other.expectedLoss)
+	}
+
+	return r.id == other.id &&
+		r.title == other.title &&
+		r.description == other.description &&
+		r.category == other.category &&
+		r.inherentScore.Equal(other.inherentScore) &&
+		r.residualScore.Equal(other.residualScore) &&
+		RiskStatusEqual(r.status, other.status) &&
+		r.ownerID == other.ownerID &&
+		sameLoss &&
+		r.statusSince.Equal(other.statusSince) &&
+		slices.Equal(r.relatedRiskIDs, other.relatedRiskIDs) &&
+		r.createdBy == other.createdBy
+}
+
+// Validate re-checks the invariants NewRisk and WithResidualScore enforce.
+// Use it on a Risk reconstructed from storage, which bypasses the constructor.
+func (r *Risk) Validate() error {
+	var errors shared.ValidationErrors
+
+	if _, err := shared.NewRiskID(string(r.id)); err != nil {
+		errors.AddError("id", err)
+	}
+
+	if r.title == "" {
 		errors.Add("title", "Risk title is required", "REQUIRED")
 	}
 
-	if errors.HasErrors() {
-		return nil, errors
+	if r.category != "" && !r.category.IsValid() {
+		errors.Add("category", fmt.Sprintf("Unknown risk category: %s", r.category), "INVALID_CATEGORY")
 	}
 
-	inherentScore := CalculateRiskScore(input.Likelihood, input.Impact)
+	scoring := r.scoring
+	if scoring == nil {
+		scoring = DefaultRiskMatrix()
+	}
+	validateRiskScore(&errors, "inherentScore", r.inherentScore, scoring)
+	validateRiskScore(&errors, "residualScore", r.residualScore, scoring)
 
-	return &Risk{
-		id:            id,
-		title:         input.Title,
-		description:   input.Description,
-		category:      input.Category,
-		inherentScore: inherentScore,
-		residualScore: inherentScore, // Initially the same
-		status:        Identified{IdentifiedAt: time.Now()},
-		ownerID:       input.OwnerID,
-	}, nil
+	if r.residualScore.value > r.inherentScore.value {
+		errors.Add("residualScore", "Residual score cannot exceed inherent score", "INVALID_RESIDUAL")
+	}
+
+	if r.status == nil {
+		errors.Add("status", "Risk status is required", "REQUIRED")
+	}
+
+	return errors.ToError()
+}
+
+// validateRiskScore appends an error if the score's levels are undefined or
+// its value and label do not match what the scoring policy produces.
+func validateRiskScore(errors *shared.ValidationErrors, field string, score RiskScore, scoring RiskScoringPolicy) {
+	if !score.likelihood.isValidFor(scoring) || !score.impact.isValidFor(scoring) {
+		errors.Add(field, "Likelihood and impact must be defined risk levels", "INVALID_RISK_LEVEL")
+		return
+	}
+	if !score.Equal(CalculateRiskScoreWith(scoring, score.likelihood, score.impact)) {
+		errors.Add(field, "Score does not match its likelihood and impact", "INVALID_SCORE")
+	}
+}
+
+// Clone returns a deep copy of the Risk.
+func (r *Risk) Clone() *Risk {
+	return r.deepCopy()
+}
+
+// clone returns a modified copy of the Risk with updatedAt set to now.
+func (r *Risk) clone() *Risk {
+	copied := r.deepCopy()
+	copied.updatedAt = shared.NowFrom(r.clock)
+	return copied
+}
+
+// deepCopy returns a copy of the Risk that shares no mutable state with the original.
+func (r *Risk) deepCopy() *Risk {
+	copied := *r
+	copied.status = cloneRiskStatus(r.status)
+	copied.relatedRiskIDs = slices.Clone(r.relatedRiskIDs)
+	return &copied
+}
+
+// cloneRiskStatus copies the slices held by a status so that callers and
+// the Risk never alias each other's data.
+func cloneRiskStatus(status RiskStatus) RiskStatus {
+	if m, ok := status.(Mitigated); ok {
+		return m.Clone()
+	}
+	return status
+}
+
+// validateRiskLevels appends an INVALID_RISK_LEVEL error for each level
+// outside the scale of the scoring policy.
+func validateRiskLevels(errors *shared.ValidationErrors, scoring RiskScoringPolicy, likelihood, impact RiskLevel) {
+	if !likelihood.isValidFor(scoring) {
+		errors.Add("likelihood", "Likelihood must be a defined risk level", "INVALID_RISK_LEVEL")
+	}
+	if !impact.isValidFor(scoring) {
+		errors.Add("impact", "Impact must be a defined risk level", "INVALID_RISK_LEVEL")
+	}
 }
 
 // WithStatus returns a new Risk with the updated status.
 func (r *Risk) WithStatus(newStatus RiskStatus) (*Risk, error) {
-	// Business rule: Cannot transition from Closed status
-	if _, isClosed := r.status.(Closed); isClosed {
-		return nil, shared.NewValidationError(
-			"status",
-			"Cannot transition from Closed status",
-			"INVALID_TRANSITION",
-		)
+	return r.WithStatusAt(shared.SystemClock{}, newStatus)
+}
+
+// WithStatusAt is like WithStatus but validates expirations against the given clock.
+// The attempt is reported to the registered TransitionObserver.
+func (r *Risk) WithStatusAt(clock shared.Clock, newStatus RiskStatus) (*Risk, error) {
+	return r.WithStatusCtx(context.Background(), clock, newStatus)
+}
+
+// WithStatusCtx is like WithStatusAt but reports the attempt to the
+// TransitionObserver carried by ctx, if any (see WithTransitionObserver).
+func (r *Risk) WithStatusCtx(ctx context.Context, clock shared.Clock, newStatus RiskStatus) (*Risk, error) {
+	updated, err := r.withStatusAt(clock, newStatus)
+	from, to := "", ""
+	if r.status != nil {
+		from = RiskStatusKind(r.status)
+	}
+	if newStatus != nil {
+		to = RiskStatusKind(newStatus)
 	}
+	notifyTransition(ctx, EntityRef{Type: "Risk", ID: string(r.id)}, from, to, err)
+	return updated, err
+}
+
+func (r *Risk) withStatusAt(clock shared.Clock, newStatus RiskStatus) (*Risk, error) {
+	if newStatus == nil {
+		return nil, shared.NewValidationError("status", "Risk status is required", "REQUIRED")
+	}
+	if r.status == nil {
+		return nil, shared.NewValidationError("status", "Risk has no current status", "REQUIRED")
+	}
+
+	// Business rule: Only transitions in the risk lifecycle are allowed;
+	// Closed has no outgoing transitions.
+	if err := riskTransitions.CanTransition(RiskStatusKind(r.status), RiskStatusKind(newStatus)); err != nil {
+		return nil, err
+	}
+
+	now := clock.Now()
 
 	// Business rule: Accepted expiration must be in the future
 	if accepted, ok := newStatus.(Accepted); ok {
-		if accepted.ExpiresAt.Before(time.Now()) {
+		if accepted.ExpiresAt.Before(now) {
 			return nil, shared.NewValidationError(
 				"expiresAt",
 				"Acceptance expiration date must be in the future",
@@ -248,44 +880,354 @@ func (r *Risk) WithStatus(newStatus RiskStatus) (*Risk, error) {
 		}
 	}
 
-	return &Risk{
-		id:            r.id,
-		title:         r.title,
-		description:   r.description,
-		category:      r.category,
-		inherentScore: r.inherentScore,
-		residualScore: r.residualScore,
-		status:        newStatus,
-		ownerID:       r.ownerID,
+	updated := r.clone()
+	updated.status = cloneRiskStatus(newStatus)
+	updated.statusSince = now
+	updated.updatedAt = now
+	return updated, nil
+}
+
+// Close returns a new Risk in Closed status at the given time.
+// Closing a risk whose residual score level is High or Critical is rejected with
+// RESIDUAL_TOO_HIGH unless force is true, in which case the override is
+// recorded on the Closed status.
+func (r *Risk) Close(resolution string, force bool, at time.Time) (*Risk, error) {
+	if resolution == "" {
+		return nil, shared.NewValidationError("resolution", "Resolution is required", "REQUIRED")
+	}
+
+	tooHigh := r.residualScore.Level() >= RiskLevelHigh
+	if tooHigh && !force {
+		return nil, shared.NewValidationError(
+			"residualScore",
+			fmt.Sprintf("Cannot close a risk with %s residual score", r.residualScore.label),
+			"RESIDUAL_TOO_HIGH",
+		)
+	}
+
+	return r.WithStatusAt(
+		shared.FixedClock{Time: at},
+		Closed{ClosedAt: at, Resolution: resolution, Forced: tooHigh},
+	)
+}
+
+// AcceptanceExpiresAt returns when the risk's acceptance expires, and false
+// if the risk is not Accepted.
+func (r *Risk) AcceptanceExpiresAt() (time.Time, bool) {
+	type expiry struct {
+		at time.Time
+		ok bool
+	}
+	e := MatchRiskStatus(
+		r.status,
+		func(time.Time) expiry { return expiry{} },
+		func(time.Time, shared.UserID) expiry { return expiry{} },
+		func(time.Time, []shared.ControlID) expiry { return expiry{} },
+		func(_ shared.UserID, _ string, expiresAt time.Time) expiry { return expiry{expiresAt, true} },
+		func(time.Time, string) expiry { return expiry{} },
+	)
+	return e.at, e.ok
+}
+
+// IsAcceptanceOverdue returns true if the risk is Accepted and now is after
+// the acceptance expiry. It only queries the status; see RefreshAcceptance
+// to reopen the risk.
+func (r *Risk) IsAcceptanceOverdue(now time.Time) bool {
+	return IsAcceptanceExpired(r.status, now)
+}
+
+// RefreshAcceptance returns the risk to Assessed if its acceptance has expired
+// as of now, so that it shows up in reviews again. The Assessed status has no
+// assessor because the re-assessment is still pending.
+// It returns the original risk and false if nothing changed.
+func (r *Risk) RefreshAcceptance(now time.Time) (*Risk, bool) {
+	if !IsAcceptanceExpired(r.status, now) {
+		return r, false
+	}
+
+	updated, err := r.WithStatusAt(shared.FixedClock{Time: now}, Assessed{AssessedAt: now})
+	if err != nil {
+		return r, false
+	}
+	return updated, true
+}
+
+// TransitionTo behaves like WithStatus but also returns a RiskStatusChanged
+// event capturing the previous and new status for audit and replay.
+func (r *Risk) TransitionTo(newStatus RiskStatus) (*Risk, RiskStatusChanged, error) {
+	return r.TransitionToAt(shared.SystemClock{}, newStatus)
+}
+
+// TransitionToAt is like TransitionTo but uses the given clock for validation
+// and the event timestamp.
+func (r *Risk) TransitionToAt(clock shared.Clock, newStatus RiskStatus) (*Risk, RiskStatusChanged, error) {
+	now := clock.Now()
+	updated, err := r.WithStatusAt(shared.FixedClock{Time: now}, newStatus)
+	if err != nil {
+		return nil, RiskStatusChanged{}, err
+	}
+	return updated, RiskStatusChanged{
+		RiskID: r.id,
+		From:   cloneRiskStatus(r.status),
+		To:     cloneRiskStatus(newStatus),
+		At:     now,
 	}, nil
 }
 
 // WithResidualScore returns a new Risk with the updated residual score.
-func (r *Risk) WithResidualScore(likelihood, impact RiskLevel) *Risk {
-	return &Risk{
-		id:            r.id,
-		title:         r.title,
-		description:   r.description,
-		category:      r.category,
-		inherentScore: r.inherentScore,
-		residualScore: CalculateRiskScore(likelihood, impact),
-		status:        r.status,
-		ownerID:       r.ownerID,
+// Mitigation can only reduce or hold risk, so a residual score higher than
+// the inherent score is rejected.
+func (r *Risk) WithResidualScore(likelihood, impact RiskLevel) (*Risk, error) {
+	var errors shared.ValidationErrors
+	validateRiskLevels(&errors, r.scoring, likelihood, impact)
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	residual := CalculateRiskScoreWith(r.scoring, likelihood, impact)
+
+	// Business rule: Residual score must not exceed inherent score
+	if residual.value > r.inherentScore.value {
+		return nil, shared.NewValidationError(
+			"residualScore",
+			"Residual score cannot exceed inherent score",
+			"INVALID_RESIDUAL",
+		)
+	}
+
+	updated := r.clone()
+	updated.residualScore = residual
+	return updated, nil
+}
+
+// SimulateResidual returns the residual score the risk would have with the
+// given likelihood and impact, using the risk's own scoring policy.
+// The risk is not modified.
+func (r *Risk) SimulateResidual(likelihood, impact RiskLevel) RiskScore {
+	return CalculateRiskScoreWith(r.scoring, likelihood, impact)
+}
+
+// SimulateMatrix returns the inherent and residual scores the risk would have
+// if it were scored with a different matrix. The risk is not modified.
+func (r *Risk) SimulateMatrix(m RiskMatrix) (inherent, residual RiskScore) {
+	inherent = m.Score(r.inherentScore.likelihood, r.inherentScore.impact)
+	residual = m.Score(r.residualScore.likelihood, r.residualScore.impact)
+	return inherent, residual
+}
+
+// ExpectedLoss returns the quantified expected loss of the risk, if any.
+func (r *Risk) ExpectedLoss() (shared.Money, bool) {
+	if r.expectedLoss == nil {
+		return shared.Money{}, false
+	}
+	return *r.expectedLoss, true
+}
+
+// WithTitle returns a new Risk with the given title.
+func (r *Risk) WithTitle(title string) (*Risk, error) {
+	if title == "" {
+		return nil, shared.NewValidationError("title", "Risk title is required", "REQUIRED")
+	}
+	updated := r.clone()
+	updated.title = title
+	return updated, nil
+}
+
+// WithCategory returns a new Risk in the given category, which must be one
+// of AllRiskCategories.
+func (r *Risk) WithCategory(category RiskCategory) (*Risk, error) {
+	if !category.IsValid() {
+		return nil, shared.NewValidationError(
+			"category",
+			fmt.Sprintf("Unknown risk category: %s", category),
+			"INVALID_CATEGORY",
+		)
+	}
+	updated := r.clone()
+	updated.category = category
+	return updated, nil
+}
+
+// WithRelatedRisk returns a new Risk related to the given risk.
+// Adding a risk that is already related is a no-op; a risk cannot relate to itself.
+func (r *Risk) WithRelatedRisk(id shared.RiskID) (*Risk, error) {
+	if id == r.id {
+		return nil, shared.NewValidationError(
+			"relatedRiskIds",
+			fmt.Sprintf("Risk %s cannot relate to itself", id),
+			"SELF_REFERENCE",
+		)
+	}
+	if slices.Contains(r.relatedRiskIDs, id) {
+		return r, nil
+	}
+	updated := r.clone()
+	updated.relatedRiskIDs = append(updated.relatedRiskIDs, id)
+	return updated, nil
+}
+
+// WithOwner returns a new Risk owned by newOwner.
+func (r *Risk) WithOwner(newOwner shared.UserID) (*Risk, error) {
+	if newOwner == "" {
+		return nil, shared.NewValidationError("ownerId", "Risk owner is required", "REQUIRED")
+	}
+	updated := r.clone()
+	updated.ownerID = newOwner
+	return updated, nil
+}
+
+// ReassignOwner behaves like WithOwner but also returns an OwnershipChanged
+// event for the audit trail.
+func (r *Risk) ReassignOwner(newOwner shared.UserID, at time.Time) (*Risk, OwnershipChanged, error) {
+	updated, err := r.WithOwner(newOwner)
+	if err != nil {
+		return nil, OwnershipChanged{}, err
+	}
+	return updated, OwnershipChanged{
+		EntityType: "Risk",
+		EntityID:   string(r.id),
+		From:       r.ownerID,
+		To:         newOwner,
+		At:         at,
+	}, nil
+}
+
+// WithExpectedLoss returns a new Risk with the given quantified expected loss.
+func (r *Risk) WithExpectedLoss(loss shared.Money) *Risk {
+	updated := r.clone()
+	updated.expectedLoss = &loss
+	return updated
+}
+
+// TotalExpectedLoss sums the expected loss of the given risks in the base currency.
+// rates maps a currency code to the number of base currency units per unit of
+// that currency; amounts already in the base currency need no rate.
+// Risks without an expected loss contribute zero.
+func TotalExpectedLoss(risks []*Risk, rates map[string]float64, baseCurrency string) (shared.Money, error) {
+	total, err := shared.NewMoney(0, baseCurrency)
+	if err != nil {
+		return shared.Money{}, err
 	}
+
+	for _, r := range risks {
+		loss, ok := r.ExpectedLoss()
+		if !ok {
+			continue
+		}
+		if loss.Currency() != baseCurrency {
+			rate, ok := rates[loss.Currency()]
+			if !ok {
+				return shared.Money{}, shared.NewValidationError(
+					"rates",
+					fmt.Sprintf("No exchange rate from %s to %s", loss.Currency(), baseCurrency),
+					"MISSING_RATE",
+				)
+			}
+			if loss, err = loss.Convert(rate, baseCurrency); err != nil {
+				return shared.Money{}, err
+			}
+		}
+		if total, err = total.Add(loss); err != nil {
+			return shared.Money{}, err
+		}
+	}
+
+	return total, nil
 }
 
-// GetRiskStatusLabel returns a localized label for the risk status.
+// OnControlFailed recalculates the residual score of every risk mitigated by
+// the failed control and returns the updated risks in the same order.
+//
+// Each control listed in Mitigated.ControlIDs is assumed to contribute an equal
+// share of the reduction from inherent to residual. Removing the failed control
+// raises likelihood and impact back toward inherent by that share (rounded up),
+// so a risk whose only mitigating control failed returns to its inherent score.
+// The failed control is also removed from the Mitigated status.
+// Risks not referencing the control are returned unchanged.
+func OnControlFailed(controlID shared.ControlID, risks []*Risk) []*Risk {
+	result := make([]*Risk, len(risks))
+	for i, r := range risks {
+		result[i] = r
+
+		mitigated, ok := r.status.(Mitigated)
+		if !ok {
+			continue
+		}
+
+		remaining := make([]shared.ControlID, 0, len(mitigated.ControlIDs))
+		for _, id := range mitigated.ControlIDs {
+			if id != controlID {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) == len(mitigated.ControlIDs) {
+			continue
+		}
+
+		n := len(mitigated.ControlIDs)
+		likelihood := raiseToward(r.residualScore.likelihood, r.inherentScore.likelihood, n)
+		impact := raiseToward(r.residualScore.impact, r.inherentScore.impact, n)
+
+		updated := r.clone()
+		updated.residualScore = CalculateRiskScoreWith(r.scoring, likelihood, impact)
+		updated.status = Mitigated{MitigatedAt: mitigated.MitigatedAt, ControlIDs: remaining}
+		result[i] = updated
+	}
+	return result
+}
+
+// raiseToward moves a residual level back toward the inherent level by
+// one of n equal shares of the gap, rounding up.
+func raiseToward(residual, inherent RiskLevel, n int) RiskLevel {
+	gap := int(inherent) - int(residual)
+	if gap <= 0 {
+		return residual
+	}
+	return residual + RiskLevel((gap+n-1)/n)
+}
+
+// RiskSLA holds the maximum time a risk may stay in each status,
+// keyed by status kind (see RiskStatusKind).
+type RiskSLA map[string]time.Duration
+
+// SLADeadline returns the time by which the risk must leave its current status.
+// It returns false if the status has no SLA or is Closed.
+func (r *Risk) SLADeadline(sla RiskSLA) (time.Time, bool) {
+	kind := RiskStatusKind(r.status)
+	if kind == RiskStatusClosed {
+		return time.Time{}, false
+	}
+	d, ok := sla[kind]
+	if !ok {
+		return time.Time{}, false
+	}
+	return r.statusSince.Add(d), true
+}
+
+// GetRiskStatusLabel returns a label for the risk status in the default language.
 func GetRiskStatusLabel(status RiskStatus) string {
+	return GetRiskStatusLabelIn(status, shared.DefaultLanguage)
+}
+
+// GetRiskStatusLabelIn returns a localized label for the risk status
+// using the templates in shared.DefaultCatalog.
+func GetRiskStatusLabelIn(status RiskStatus, lang shared.Language) string {
 	return MatchRiskStatus(
 		status,
-		func(t time.Time) string { return fmt.Sprintf("特定済み (%s)", t.Format(time.RFC3339)) },
-		func(t time.Time, _ shared.UserID) string { return fmt.Sprintf("評価済み (%s)", t.Format(time.RFC3339)) },
+		func(t time.Time) string {
+			return shared.DefaultCatalog.Format(lang, MsgRiskIdentified, t.Format(time.RFC3339))
+		},
+		func(t time.Time, _ shared.UserID) string {
+			return shared.DefaultCatalog.Format(lang, MsgRiskAssessed, t.Format(time.RFC3339))
+		},
 		func(_ time.Time, controlIDs []shared.ControlID) string {
-			return fmt.Sprintf("軽減済み (%d件の統制)", len(controlIDs))
+			return shared.DefaultCatalog.Format(lang, MsgRiskMitigated, len(controlIDs))
 		},
 		func(_ shared.UserID, reason string, expiresAt time.Time) string {
-			return fmt.Sprintf("受容 (%s, 期限: %s)", reason, expiresAt.Format(time.RFC3339))
+			return shared.DefaultCatalog.Format(lang, MsgRiskAccepted, reason, expiresAt.Format(time.RFC3339))
+		},
+		func(_ time.Time, resolution string) string {
+			return shared.DefaultCatalog.Format(lang, MsgRiskClosed, resolution)
 		},
-		func(_ time.Time, resolution string) string { return fmt.Sprintf("クローズ (%s)", resolution) },
 	)
 }
@@ -0,0 +1,431 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// RiskWorsening describes a risk whose residual score increased between two assessments.
+type RiskWorsening struct {
+	RiskID shared.RiskID
+	Before RiskScore
+	After  RiskScore
+	Delta  int
+}
+
+// DetectWorseningRisks matches risks by ID and reports those whose residual
+// score value increased, sorted by largest worsening first.
+// Risks present in only one of the sets are ignored.
+func DetectWorseningRisks(before, after []*Risk) []RiskWorsening {
+	previous := make(map[shared.RiskID]*Risk, len(before))
+	for _, r := range before {
+		previous[r.id] = r
+	}
+
+	var result []RiskWorsening
+	for _, r := range after {
+		old, ok := previous[r.id]
+		if !ok {
+			continue
+		}
+		delta := r.residualScore.value - old.residualScore.value
+		if delta > 0 {
+			result = append(result, RiskWorsening{
+				RiskID: r.id,
+				Before: old.residualScore,
+				After:  r.residualScore,
+				Delta:  delta,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Delta > result[j].Delta
+	})
+	return result
+}
+
+// MergeDuplicateRisks collapses risks sharing the same normalized title and
+// category into the one chosen by keep, which must be a member of the group.
+// The mitigating controls of every duplicate are unioned into the kept risk,
+// which must therefore be Mitigated if any duplicate has controls.
+// The result keeps each group at the position of its first member;
+// non-duplicate risks pass through unchanged.
+func MergeDuplicateRisks(risks []*Risk, keep func([]*Risk) *Risk) ([]*Risk, error) {
+	groups := make(map[string][]*Risk)
+	var order []string
+	for _, r := range risks {
+		key := duplicateKey(r)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+
+	result := make([]*Risk, 0, len(order))
+	for _, key := range order {
+		group := groups[key]
+		if len(group) == 1 {
+			result = append(result, group[0])
+			continue
+		}
+
+		merged, err := mergeRiskGroup(group, keep(group))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, merged)
+	}
+	return result, nil
+}
+
+func duplicateKey(r *Risk) string {
+	title := strings.Join(strings.Fields(strings.ToLower(r.title)), " ")
+	return string(r.category) + "\x00" + title
+}
+
+func mergeRiskGroup(group []*Risk, kept *Risk) (*Risk, error) {
+	found := false
+	for _, r := range group {
+		if r == kept {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, shared.NewValidationError(
+			"keep",
+			fmt.Sprintf("Selected risk is not a member of the duplicate group %q", group[0].title),
+			"INVALID_SELECTION",
+		)
+	}
+
+	seen := make(map[shared.ControlID]bool)
+	var controlIDs []shared.ControlID
+	for _, r := range append([]*Risk{kept}, group...) {
+		if m, ok := r.status.(Mitigated); ok {
+			for _, id := range m.ControlIDs {
+				if !seen[id] {
+					seen[id] = true
+					controlIDs = append(controlIDs, id)
+				}
+			}
+		}
+	}
+
+	if len(controlIDs) == 0 {
+		return kept, nil
+	}
+
+	mitigated, ok := kept.status.(Mitigated)
+	if !ok {
+		return nil, shared.NewValidationError(
+			"keep",
+			fmt.Sprintf("Risk %s must be Mitigated to consolidate controls from its duplicates", kept.id),
+			"INVALID_MERGE",
+		)
+	}
+
+	merged := kept.clone()
+	merged.status = Mitigated{MitigatedAt: mitigated.MitigatedAt, ControlIDs: controlIDs}
+	return merged, nil
+}
+
+// BreachedSLAs returns the risks whose SLA deadline for their current status
+// has passed at the given time, in input order.
+func BreachedSLAs(risks []*Risk, sla RiskSLA, now time.Time) []*Risk {
+	var breached []*Risk
+	for _, r := range risks {
+		if deadline, ok := r.SLADeadline(sla); ok && now.After(deadline) {
+			breached = append(breached, r)
+		}
+	}
+	return breached
+}
+
+// IncidentOutcome pairs a predicted risk score with the impact actually observed.
+type IncidentOutcome struct {
+	Predicted RiskScore
+	Observed  RiskLevel
+}
+
+// CalibrationReport summarizes how well a matrix predicted incident outcomes.
+type CalibrationReport struct {
+	Total          int
+	Matched        int
+	Overestimated  int
+	Underestimated int
+}
+
+// MatchRate returns the fraction of incidents whose predicted level matched.
+func (c CalibrationReport) MatchRate() float64 { return c.rate(c.Matched) }
+
+// OverestimateRate returns the fraction of incidents predicted too high.
+func (c CalibrationReport) OverestimateRate() float64 { return c.rate(c.Overestimated) }
+
+// UnderestimateRate returns the fraction of incidents predicted too low.
+func (c CalibrationReport) UnderestimateRate() float64 { return c.rate(c.Underestimated) }
+
+func (c CalibrationReport) rate(n int) float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(n) / float64(c.Total)
+}
+
+// IsWellCalibrated returns true if the share of mispredicted incidents is at
+// most tolerance (e.g. 0.2 for 20%). A report without incidents is not calibrated.
+func (c CalibrationReport) IsWellCalibrated(tolerance float64) bool {
+	return c.Total > 0 && 1-c.MatchRate() <= tolerance
+}
+
+// CalibrateMatrix re-scores each incident's prediction with the matrix and
+// compares the resulting band, as a RiskLevel, with the observed impact.
+// A band whose label matches a RiskLevel name maps to that level; otherwise
+// band positions are spread evenly over the RiskLevel scale.
+func CalibrateMatrix(m RiskMatrix, incidents []IncidentOutcome) CalibrationReport {
+	report := CalibrationReport{Total: len(incidents)}
+	for _, incident := range incidents {
+		predicted := m.predictedLevel(incident.Predicted.likelihood, incident.Predicted.impact)
+		switch predicted.Compare(incident.Observed) {
+		case 0:
+			report.Matched++
+		case 1:
+			report.Overestimated++
+		default:
+			report.Underestimated++
+		}
+	}
+	return report
+}
+
+// predictedLevel maps the band of a score onto the RiskLevel scale.
+func (m RiskMatrix) predictedLevel(likelihood, impact RiskLevel) RiskLevel {
+	bands := m.bands
+	if len(bands) == 0 {
+		bands = DefaultRiskMatrix().bands
+	}
+
+	_, label := m.Evaluate(likelihood, impact)
+	for l := RiskLevelNegligible; l <= RiskLevelCritical; l++ {
+		if l.String() == label {
+			return l
+		}
+	}
+
+	if len(bands) == 1 {
+		return RiskLevelCritical
+	}
+	index := len(bands) - 1
+	for i, b := range bands {
+		if b.Label == label {
+			index = i
+			break
+		}
+	}
+	span := float64(RiskLevelCritical - RiskLevelLow)
+	offset := math.Round(float64(index) * span / float64(len(bands)-1))
+	return RiskLevelLow + RiskLevel(offset)
+}
+
+// BuildRiskHeatMap counts risks per likelihood/impact cell. The result is
+// indexed by [likelihood-1][impact-1] and has one row and column per risk
+// level on the default scale. useResidual selects the residual score instead
+// of the inherent one. Risks with undefined or Negligible levels are skipped.
+func BuildRiskHeatMap(risks []*Risk, useResidual bool) [][]int {
+	size := int(RiskLevelCritical)
+	heatMap := make([][]int, size)
+	for i := range heatMap {
+		heatMap[i] = make([]int, size)
+	}
+
+	for _, r := range risks {
+		score := r.inherentScore
+		if useResidual {
+			score = r.residualScore
+		}
+		l, i := score.Cell()
+		if l < 0 {
+			continue
+		}
+		heatMap[l][i]++
+	}
+	return heatMap
+}
+
+// TopRisks returns at most n risks ordered by score value, highest first.
+// byResidual ranks by residual score instead of inherent score. Ties are
+// broken by higher likelihood, then by ID. The input slice is not modified.
+func TopRisks(risks []*Risk, n int, byResidual bool) []*Risk {
+	score := func(r *Risk) RiskScore {
+		if byResidual {
+			return r.residualScore
+		}
+		return r.inherentScore
+	}
+
+	ranked := make([]*Risk, len(risks))
+	copy(ranked, risks)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		si, sj := score(ranked[i]), score(ranked[j])
+		if si.value != sj.value {
+			return si.value > sj.value
+		}
+		if si.likelihood != sj.likelihood {
+			return si.likelihood > sj.likelihood
+		}
+		return ranked[i].id < ranked[j].id
+	})
+
+	return ranked[:max(0, min(n, len(ranked)))]
+}
+
+// RiskTransitionError records why a risk in a batch could not be transitioned.
+type RiskTransitionError struct {
+	RiskID shared.RiskID
+	Err    error
+}
+
+func (e RiskTransitionError) Error() string {
+	return fmt.Sprintf("risk %s: %v", e.RiskID, e.Err)
+}
+
+func (e RiskTransitionError) Unwrap() error {
+	return e.Err
+}
+
+// TransitionRisks applies WithStatus to each risk, continuing past failures.
+// It returns the successfully transitioned risks and one error per failed
+// risk, both in input order.
+func TransitionRisks(risks []*Risk, newStatus RiskStatus) ([]*Risk, []RiskTransitionError) {
+	var updated []*Risk
+	var failures []RiskTransitionError
+	for _, r := range risks {
+		next, err := r.WithStatus(newStatus)
+		if err != nil {
+			failures = append(failures, RiskTransitionError{RiskID: r.id, Err: err})
+			continue
+		}
+		updated = append(updated, next)
+	}
+	return updated, failures
+}
+
+// BuildRiskGraph maps each risk to the risks it relates to.
+// Risks without relations are included with no edges.
+func BuildRiskGraph(risks []*Risk) map[shared.RiskID][]shared.RiskID {
+	graph := make(map[shared.RiskID][]shared.RiskID, len(risks))
+	for _, r := range risks {
+		graph[r.id] = r.RelatedRiskIDs()
+	}
+	return graph
+}
+
+// FindRiskCycle returns a cycle in the risk relationships, starting and
+// ending with the same ID (e.g. [a, b, a]), or nil if there is none.
+// Risks are visited in input order so the result is deterministic.
+func FindRiskCycle(risks []*Risk) []shared.RiskID {
+	graph := BuildRiskGraph(risks)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[shared.RiskID]int, len(graph))
+	var path []shared.RiskID
+
+	var visit func(id shared.RiskID) []shared.RiskID
+	visit = func(id shared.RiskID) []shared.RiskID {
+		state[id] = visiting
+		path = append(path, id)
+		for _, next := range graph[id] {
+			switch state[next] {
+			case visiting:
+				start := slices.Index(path, next)
+				return append(slices.Clone(path[start:]), next)
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, r := range risks {
+		if state[r.id] == unvisited {
+			if cycle := visit(r.id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// ControlsToRisks inverts the Mitigated statuses into an index of the risks
+// each control mitigates, in input order. Risks in any other status are ignored.
+func ControlsToRisks(risks []*Risk) map[shared.ControlID][]shared.RiskID {
+	index := make(map[shared.ControlID][]shared.RiskID)
+	for _, r := range risks {
+		mitigated, ok := r.status.(Mitigated)
+		if !ok {
+			continue
+		}
+		for _, controlID := range mitigated.ControlIDs {
+			index[controlID] = append(index[controlID], r.id)
+		}
+	}
+	return index
+}
+
+// RisksMitigatedBy returns the Mitigated risks that list the control, in input order.
+func RisksMitigatedBy(controlID shared.ControlID, risks []*Risk) []*Risk {
+	var result []*Risk
+	for _, r := range risks {
+		if mitigated, ok := r.status.(Mitigated); ok && slices.Contains(mitigated.ControlIDs, controlID) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// AffectedRisksByControlFailure returns the IDs of the risks whose Mitigated
+// status includes the failed control, in input order.
+func AffectedRisksByControlFailure(failed *Control, risks []*Risk) []shared.RiskID {
+	var affected []shared.RiskID
+	for _, r := range RisksMitigatedBy(failed.id, risks) {
+		affected = append(affected, r.id)
+	}
+	return affected
+}
+
+// ReassessmentSuggestion recommends moving a risk to another status.
+type ReassessmentSuggestion struct {
+	RiskID shared.RiskID
+	// To is the suggested status kind (see RiskStatusKind).
+	To     string
+	Reason string
+}
+
+// SuggestReassessment recommends that each risk affected by the failed
+// control move back to Assessed, since its mitigation can no longer be relied on.
+func SuggestReassessment(failed *Control, risks []*Risk) []ReassessmentSuggestion {
+	var suggestions []ReassessmentSuggestion
+	for _, id := range AffectedRisksByControlFailure(failed, risks) {
+		suggestions = append(suggestions, ReassessmentSuggestion{
+			RiskID: id,
+			To:     RiskStatusAssessed,
+			Reason: fmt.Sprintf("Mitigating control %s (%s) failed", failed.id, failed.code),
+		})
+	}
+	return suggestions
+}
@@ -0,0 +1,317 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// withResidual returns r with its residual score set, failing the test on error.
+func withResidual(t *testing.T, r *Risk, likelihood, impact RiskLevel) *Risk {
+	t.Helper()
+	updated, err := r.WithResidualScore(likelihood, impact)
+	if err != nil {
+		t.Fatalf("WithResidualScore() error = %v", err)
+	}
+	return updated
+}
+
+func TestDetectWorseningRisks(t *testing.T) {
+	worsening := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh)
+	improving := newTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh)
+	added := newTestRisk(t, "risk-3", RiskLevelCritical, RiskLevelCritical)
+
+	before := []*Risk{withResidual(t, worsening, RiskLevelLow, RiskLevelLow), improving}
+	after := []*Risk{
+		withResidual(t, worsening, RiskLevelMedium, RiskLevelMedium),
+		withResidual(t, improving, RiskLevelLow, RiskLevelLow),
+		added,
+	}
+
+	got := DetectWorseningRisks(before, after)
+
+	if len(got) != 1 {
+		t.Fatalf("DetectWorseningRisks() = %v, want only risk-1", got)
+	}
+	if got[0].RiskID != "risk-1" || got[0].Delta != 3 {
+		t.Errorf("got %s with delta %d, want risk-1 with delta 3", got[0].RiskID, got[0].Delta)
+	}
+}
+
+// withTitle returns r with the given title, failing the test on error.
+func withTitle(t *testing.T, r *Risk, title string) *Risk {
+	t.Helper()
+	updated, err := r.WithTitle(title)
+	if err != nil {
+		t.Fatalf("WithTitle() error = %v", err)
+	}
+	return updated
+}
+
+func TestMergeDuplicateRisks(t *testing.T) {
+	first := withTitle(t, mitigatedTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1", "ctrl-2"), "Phishing")
+	other := newTestRisk(t, "risk-2", RiskLevelLow, RiskLevelLow)
+	second := withTitle(t, mitigatedTestRisk(t, "risk-3", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-2", "ctrl-3"), "  phishing ")
+
+	got, err := MergeDuplicateRisks(
+		[]*Risk{first, other, second},
+		func(group []*Risk) *Risk { return group[0] },
+	)
+	if err != nil {
+		t.Fatalf("MergeDuplicateRisks() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0].ID() != "risk-1" || got[1] != other {
+		t.Fatalf("MergeDuplicateRisks() = %v, want [risk-1, risk-2]", got)
+	}
+	controls := got[0].Status().(Mitigated).ControlIDs
+	if want := []shared.ControlID{"ctrl-1", "ctrl-2", "ctrl-3"}; !slices.Equal(controls, want) {
+		t.Errorf("merged ControlIDs = %v, want %v", controls, want)
+	}
+	if len(first.Status().(Mitigated).ControlIDs) != 2 {
+		t.Error("MergeDuplicateRisks() modified the kept risk")
+	}
+}
+
+func TestBreachedSLAs(t *testing.T) {
+	sla := RiskSLA{RiskStatusIdentified: 72 * time.Hour}
+	overdue := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh)
+	assessed := transitionRisk(t, newTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"})
+
+	deadline, ok := overdue.SLADeadline(sla)
+	if !ok || !deadline.Equal(testNow.Add(72*time.Hour)) {
+		t.Fatalf("SLADeadline() = %s, %v, want %s", deadline, ok, testNow.Add(72*time.Hour))
+	}
+
+	risks := []*Risk{overdue, assessed}
+	if got := BreachedSLAs(risks, sla, deadline); len(got) != 0 {
+		t.Errorf("BreachedSLAs() at the deadline = %v, want none", got)
+	}
+	got := BreachedSLAs(risks, sla, deadline.Add(time.Second))
+	if len(got) != 1 || got[0] != overdue {
+		t.Errorf("BreachedSLAs() after the deadline = %v, want [risk-1]", got)
+	}
+}
+
+func TestCalibrateMatrix(t *testing.T) {
+	incidents := []IncidentOutcome{
+		{Predicted: CalculateRiskScore(RiskLevelLow, RiskLevelLow), Observed: RiskLevelLow},
+		{Predicted: CalculateRiskScore(RiskLevelHigh, RiskLevelHigh), Observed: RiskLevelHigh},
+		{Predicted: CalculateRiskScore(RiskLevelCritical, RiskLevelCritical), Observed: RiskLevelMedium},
+		{Predicted: CalculateRiskScore(RiskLevelLow, RiskLevelMedium), Observed: RiskLevelHigh},
+	}
+
+	got := CalibrateMatrix(DefaultRiskMatrix(), incidents)
+
+	if got.Total != 4 || got.MatchRate() != 0.5 || got.OverestimateRate() != 0.25 || got.UnderestimateRate() != 0.25 {
+		t.Errorf("CalibrateMatrix() = %+v, want 50%% matched, 25%% over, 25%% under", got)
+	}
+	if !got.IsWellCalibrated(0.5) || got.IsWellCalibrated(0.4) {
+		t.Error("IsWellCalibrated() does not compare the mismatch rate with the tolerance")
+	}
+	if (CalibrationReport{}).IsWellCalibrated(1) {
+		t.Error("IsWellCalibrated() = true without incidents")
+	}
+}
+
+func TestBuildRiskHeatMap(t *testing.T) {
+	negligible, err := NewRiskBuilder().
+		WithID("risk-negligible").
+		WithTitle("Typo in footer").
+		WithCategory(RiskCategoryOperational).
+		WithLikelihood(RiskLevelNegligible).
+		WithImpact(RiskLevelLow).
+		WithOwner("user-1").
+		WithScoring(FivePointRiskMatrix()).
+		WithClock(testClock).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	risks := []*Risk{
+		newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelMedium),
+		newTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelMedium),
+		withResidual(t, newTestRisk(t, "risk-3", RiskLevelLow, RiskLevelCritical), RiskLevelLow, RiskLevelLow),
+		negligible, // outside the 4×4 grid, skipped
+	}
+
+	inherent := BuildRiskHeatMap(risks, false)
+	wantInherent := [][]int{
+		{0, 0, 0, 1},
+		{0, 0, 0, 0},
+		{0, 2, 0, 0},
+		{0, 0, 0, 0},
+	}
+	if !slices.EqualFunc(inherent, wantInherent, slices.Equal[[]int]) {
+		t.Errorf("BuildRiskHeatMap(inherent) = %v, want %v", inherent, wantInherent)
+	}
+
+	residual := BuildRiskHeatMap(risks, true)
+	if residual[0][0] != 1 || residual[0][3] != 0 || residual[2][1] != 2 {
+		t.Errorf("BuildRiskHeatMap(residual) = %v, want risk-3 moved to Low×Low", residual)
+	}
+}
+
+func TestTopRisks(t *testing.T) {
+	risks := []*Risk{
+		newTestRisk(t, "risk-c", RiskLevelMedium, RiskLevelHigh), // 6, lower likelihood
+		newTestRisk(t, "risk-b", RiskLevelHigh, RiskLevelMedium), // 6
+		newTestRisk(t, "risk-d", RiskLevelLow, RiskLevelLow),     // 1
+		newTestRisk(t, "risk-a", RiskLevelHigh, RiskLevelMedium), // 6, same as risk-b
+		newTestRisk(t, "risk-e", RiskLevelCritical, RiskLevelCritical),
+	}
+	input := slices.Clone(risks)
+
+	ids := func(rs []*Risk) []shared.RiskID {
+		var out []shared.RiskID
+		for _, r := range rs {
+			out = append(out, r.ID())
+		}
+		return out
+	}
+
+	if got, want := ids(TopRisks(risks, 10, false)), []shared.RiskID{"risk-e", "risk-a", "risk-b", "risk-c", "risk-d"}; !slices.Equal(got, want) {
+		t.Errorf("TopRisks(10) = %v, want %v", got, want)
+	}
+	if got, want := ids(TopRisks(risks, 2, false)), []shared.RiskID{"risk-e", "risk-a"}; !slices.Equal(got, want) {
+		t.Errorf("TopRisks(2) = %v, want %v", got, want)
+	}
+	if got := TopRisks(risks, 0, false); len(got) != 0 {
+		t.Errorf("TopRisks(0) = %v, want none", ids(got))
+	}
+	if !slices.Equal(risks, input) {
+		t.Error("TopRisks() reordered its input")
+	}
+}
+
+func TestTransitionRisksReportsPartialFailures(t *testing.T) {
+	closed := transitionRisk(t,
+		mitigatedTestRisk(t, "risk-2", RiskLevelLow, RiskLevelLow, RiskLevelLow, RiskLevelLow, "ctrl-1"),
+		Closed{ClosedAt: testNow, Resolution: "retired system"},
+	)
+	risks := []*Risk{
+		newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh),
+		closed,
+		newTestRisk(t, "risk-3", RiskLevelMedium, RiskLevelLow),
+	}
+
+	updated, failures := TransitionRisks(risks, Assessed{AssessedAt: testNow, AssessorID: "user-2"})
+
+	if len(updated) != 2 || updated[0].ID() != "risk-1" || updated[1].ID() != "risk-3" {
+		t.Fatalf("TransitionRisks() updated = %v, want risk-1 and risk-3", updated)
+	}
+	for _, r := range updated {
+		if _, ok := r.Status().(Assessed); !ok {
+			t.Errorf("%s Status() = %v, want Assessed", r.ID(), r.Status())
+		}
+	}
+	if len(failures) != 1 || failures[0].RiskID != "risk-2" || !errors.Is(failures[0], shared.ErrInvalidTransition) {
+		t.Errorf("TransitionRisks() failures = %v, want one invalid transition for risk-2", failures)
+	}
+}
+
+// relateRisk links r to the given risks, failing the test on error.
+func relateRisk(t *testing.T, r *Risk, ids ...shared.RiskID) *Risk {
+	t.Helper()
+	for _, id := range ids {
+		var err error
+		if r, err = r.WithRelatedRisk(id); err != nil {
+			t.Fatalf("WithRelatedRisk(%s) error = %v", id, err)
+		}
+	}
+	return r
+}
+
+func TestWithRelatedRiskRejectsSelfReference(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+	if _, err := r.WithRelatedRisk("risk-1"); !hasCode(err, "SELF_REFERENCE") {
+		t.Errorf("WithRelatedRisk(self) error = %v, want SELF_REFERENCE", err)
+	}
+
+	r = relateRisk(t, r, "risk-2", "risk-2")
+	if got := r.RelatedRiskIDs(); !slices.Equal(got, []shared.RiskID{"risk-2"}) {
+		t.Errorf("RelatedRiskIDs() = %v, want [risk-2]", got)
+	}
+}
+
+func TestFindRiskCycle(t *testing.T) {
+	a := relateRisk(t, newTestRisk(t, "risk-a", RiskLevelLow, RiskLevelLow), "risk-b")
+	b := relateRisk(t, newTestRisk(t, "risk-b", RiskLevelLow, RiskLevelLow), "risk-c")
+	c := newTestRisk(t, "risk-c", RiskLevelLow, RiskLevelLow)
+
+	if got := FindRiskCycle([]*Risk{a, b, c}); got != nil {
+		t.Errorf("FindRiskCycle(chain) = %v, want nil", got)
+	}
+
+	c = relateRisk(t, c, "risk-a")
+	want := []shared.RiskID{"risk-a", "risk-b", "risk-c", "risk-a"}
+	if got := FindRiskCycle([]*Risk{a, b, c}); !slices.Equal(got, want) {
+		t.Errorf("FindRiskCycle(loop) = %v, want %v", got, want)
+	}
+}
+
+func TestRisksMitigatedByExcludesOtherStatuses(t *testing.T) {
+	mitigated := mitigatedTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1", "ctrl-2")
+	alsoMitigated := mitigatedTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1")
+	assessed := transitionRisk(t, newTestRisk(t, "risk-3", RiskLevelHigh, RiskLevelHigh),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"})
+	closed := transitionRisk(t,
+		mitigatedTestRisk(t, "risk-4", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1"),
+		Closed{ClosedAt: testNow, Resolution: "decommissioned"},
+	)
+	risks := []*Risk{mitigated, assessed, alsoMitigated, closed}
+
+	var got []shared.RiskID
+	for _, r := range RisksMitigatedBy("ctrl-1", risks) {
+		got = append(got, r.ID())
+	}
+	if want := []shared.RiskID{"risk-1", "risk-2"}; !slices.Equal(got, want) {
+		t.Errorf("RisksMitigatedBy(ctrl-1) = %v, want %v", got, want)
+	}
+
+	index := ControlsToRisks(risks)
+	if len(index) != 2 ||
+		!slices.Equal(index["ctrl-1"], []shared.RiskID{"risk-1", "risk-2"}) ||
+		!slices.Equal(index["ctrl-2"], []shared.RiskID{"risk-1"}) {
+		t.Errorf("ControlsToRisks() = %v, want ctrl-1 -> [risk-1 risk-2], ctrl-2 -> [risk-1]", index)
+	}
+}
+
+func TestControlFailureImpact(t *testing.T) {
+	half, err := shared.NewPercentage(50)
+	if err != nil {
+		t.Fatalf("NewPercentage() error = %v", err)
+	}
+	failed := func(id string) *Control {
+		return newTestControl(t, id, InProgress{Progress: half}, Failed{Reason: "audit finding", DetectedAt: testNow})
+	}
+	risks := []*Risk{
+		mitigatedTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1"),
+		newTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh),
+	}
+
+	t.Run("unreferenced control", func(t *testing.T) {
+		c := failed("ctrl-9")
+		if got := AffectedRisksByControlFailure(c, risks); len(got) != 0 {
+			t.Errorf("AffectedRisksByControlFailure() = %v, want none", got)
+		}
+		if got := SuggestReassessment(c, risks); len(got) != 0 {
+			t.Errorf("SuggestReassessment() = %v, want none", got)
+		}
+	})
+
+	t.Run("mitigating control", func(t *testing.T) {
+		c := failed("ctrl-1")
+		if got := AffectedRisksByControlFailure(c, risks); !slices.Equal(got, []shared.RiskID{"risk-1"}) {
+			t.Errorf("AffectedRisksByControlFailure() = %v, want [risk-1]", got)
+		}
+		got := SuggestReassessment(c, risks)
+		if len(got) != 1 || got[0].RiskID != "risk-1" || got[0].To != RiskStatusAssessed {
+			t.Errorf("SuggestReassessment() = %+v, want risk-1 back to Assessed", got)
+		}
+	})
+}
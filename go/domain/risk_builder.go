@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+// RiskBuilder constructs a Risk fluently. Each With method returns a new
+// builder, so a partially configured builder can be reused safely.
+//
+// Build validates everything NewRisk does, including a missing ID, and also
+// requires a category and an owner.
+type RiskBuilder struct {
+	input CreateRiskInput
+	clock shared.Clock
+}
+
+// NewRiskBuilder creates an empty RiskBuilder.
+func NewRiskBuilder() RiskBuilder {
+	return RiskBuilder{}
+}
+
+// Fluent setters for RiskBuilder
+func (b RiskBuilder) WithID(id string) RiskBuilder {
+	b.input.ID = id
+	return b
+}
+
+// WithGeneratedID sets a newly generated ID (see shared.GenerateRiskID).
+func (b RiskBuilder) WithGeneratedID() RiskBuilder {
+	b.input.ID = string(shared.GenerateRiskID())
+	return b
+}
+
+func (b RiskBuilder) WithTitle(title string) RiskBuilder {
+	b.input.Title = title
+	return b
+}
+
+func (b RiskBuilder) WithDescription(description string) RiskBuilder {
+	b.input.Description = description
+	return b
+}
+
+func (b RiskBuilder) WithCategory(category RiskCategory) RiskBuilder {
+	b.input.Category = category
+	return b
+}
+
+func (b RiskBuilder) WithLikelihood(likelihood RiskLevel) RiskBuilder {
+	b.input.Likelihood = likelihood
+	return b
+}
+
+func (b RiskBuilder) WithImpact(impact RiskLevel) RiskBuilder {
+	b.input.Impact = impact
+	return b
+}
+
+func (b RiskBuilder) WithOwner(ownerID shared.UserID) RiskBuilder {
+	b.input.OwnerID = ownerID
+	return b
+}
+
+func (b RiskBuilder) WithScoring(policy RiskScoringPolicy) RiskBuilder {
+	b.input.Scoring = policy
+	return b
+}
+
+func (b RiskBuilder) WithClock(clock shared.Clock) RiskBuilder {
+	b.clock = clock
+	return b
+}
+
+// Build creates the Risk, reporting every missing or invalid field in a
+// single ValidationErrors.
+func (b RiskBuilder) Build() (*Risk, error) {
+	var errors shared.ValidationErrors
+
+	if b.input.Category == "" {
+		errors.Add("category", "Risk category is required", "REQUIRED")
+	}
+
+	if b.input.OwnerID == "" {
+		errors.Add("ownerId", "Risk owner is required", "REQUIRED")
+	}
+
+	return b.build(errors)
+}
+
+// build validates the input, appending to any errors already found, and
+// creates the Risk.
+func (b RiskBuilder) build(errors shared.ValidationErrors) (*Risk, error) {
+	input := b.input
+
+	id, err := shared.NewRiskID(input.ID)
+	if err != nil {
+		errors.AddError("id", err)
+	}
+
+	if input.Title == "" {
+		errors.Add("title", "Risk title is required", "REQUIRED")
+	}
+
+	if input.Category != "" && !input.Category.IsValid() {
+		errors.Add("category", fmt.Sprintf("Unknown risk category: %s", input.Category), "INVALID_CATEGORY")
+	}
+
+	scoring := input.Scoring
+	if scoring == nil {
+		scoring = DefaultRiskMatrix()
+	}
+
+	validateRiskLevels(&errors, scoring, input.Likelihood, input.Impact)
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	inherentScore := CalculateRiskScoreWith(scoring, input.Likelihood, input.Impact)
+
+	clock := b.clock
+	if clock == nil {
+		clock = shared.SystemClock{}
+	}
+	now := clock.Now()
+
+	return &Risk{
+		id:            id,
+		title:         input.Title,
+		description:   input.Description,
+		category:      input.Category,
+		inherentScore: inherentScore,
+		residualScore: inherentScore, // Initially the same
+		scoring:       scoring,
+		status:        Identified{IdentifiedAt: now},
+		ownerID:       input.OwnerID,
+		statusSince:   now,
+		clock:         clock,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestRiskBuilderPartialBuilds(t *testing.T) {
+	complete := NewRiskBuilder().
+		WithID("risk-1").
+		WithTitle("Unpatched servers").
+		WithCategory(RiskCategoryTechnical).
+		WithLikelihood(RiskLevelHigh).
+		WithImpact(RiskLevelMedium).
+		WithOwner("user-1").
+		WithClock(testClock)
+
+	tests := []struct {
+		name      string
+		builder   RiskBuilder
+		wantCodes map[string]string // field -> code
+	}{
+		{"complete", complete, nil},
+		{"missing id", complete.WithID(""), map[string]string{"id": "EMPTY_ID"}},
+		{"missing category and owner", complete.WithCategory("").WithOwner(""), map[string]string{
+			"category": "REQUIRED",
+			"ownerId":  "REQUIRED",
+		}},
+		{"empty builder", NewRiskBuilder(), map[string]string{
+			"id":       "EMPTY_ID",
+			"title":    "REQUIRED",
+			"category": "REQUIRED",
+			"ownerId":  "REQUIRED",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := tt.builder.Build()
+			if tt.wantCodes == nil {
+				if err != nil {
+					t.Fatalf("Build() error = %v", err)
+				}
+				if r.ID() != "risk-1" || !r.CreatedAt().Equal(testNow) {
+					t.Errorf("Build() = %s created %v, want risk-1 created %v", r.ID(), r.CreatedAt(), testNow)
+				}
+				return
+			}
+
+			errs, ok := err.(shared.ValidationErrors)
+			if !ok {
+				t.Fatalf("Build() error = %v, want ValidationErrors", err)
+			}
+			for field, code := range tt.wantCodes {
+				if got := errs.FieldErrors(field); len(got) != 1 || got[0].Code != code {
+					t.Errorf("FieldErrors(%s) = %v, want %s", field, got, code)
+				}
+			}
+		})
+	}
+}
+
+func TestRiskBuilderWithGeneratedID(t *testing.T) {
+	r, err := NewRiskBuilder().
+		WithGeneratedID().
+		WithTitle("Unpatched servers").
+		WithCategory(RiskCategoryTechnical).
+		WithLikelihood(RiskLevelLow).
+		WithImpact(RiskLevelLow).
+		WithOwner("user-1").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if _, err := shared.NewRiskID(string(r.ID())); err != nil {
+		t.Errorf("generated ID %q is invalid: %v", r.ID(), err)
+	}
+}
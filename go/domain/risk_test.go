@@ -0,0 +1,903 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/example/grc-domain-models/domain/shared"
+)
+
+func TestOnControlFailed(t *testing.T) {
+	affected := mitigatedTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1", "ctrl-2")
+	unrelated := mitigatedTestRisk(t, "risk-2", RiskLevelHigh, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-3")
+
+	got := OnControlFailed("ctrl-1", []*Risk{affected, unrelated})
+
+	// Half of the Low→High gap (2 levels) is restored: Low+1 = Medium.
+	residual := got[0].ResidualScore()
+	if residual.Likelihood() != RiskLevelMedium || residual.Impact() != RiskLevelMedium {
+		t.Errorf("residual = %s×%s, want Medium×Medium", residual.Likelihood(), residual.Impact())
+	}
+	mitigated := got[0].Status().(Mitigated)
+	if !slices.Equal(mitigated.ControlIDs, []shared.ControlID{"ctrl-2"}) {
+		t.Errorf("ControlIDs = %v, want [ctrl-2]", mitigated.ControlIDs)
+	}
+	if affected.ResidualScore().Likelihood() != RiskLevelLow {
+		t.Error("OnControlFailed modified the original risk")
+	}
+	if got[1] != unrelated {
+		t.Error("risk not referencing the control was replaced")
+	}
+}
+
+func TestOnControlFailedOnlyControlRestoresInherent(t *testing.T) {
+	r := mitigatedTestRisk(t, "risk-1", RiskLevelCritical, RiskLevelHigh, RiskLevelLow, RiskLevelLow, "ctrl-1")
+
+	got := OnControlFailed("ctrl-1", []*Risk{r})[0]
+
+	if !got.ResidualScore().Equal(got.InherentScore()) {
+		t.Errorf("residual = %v, want inherent %v", got.ResidualScore(), got.InherentScore())
+	}
+}
+
+func TestNewRiskRejectsInvalidID(t *testing.T) {
+	_, err := NewRiskAt(testClock, CreateRiskInput{
+		Title:      "Unowned",
+		Likelihood: RiskLevelLow,
+		Impact:     RiskLevelLow,
+	})
+	if !errors.Is(err, shared.ErrEmptyID) {
+		t.Fatalf("NewRiskAt() error = %v, want EMPTY_ID", err)
+	}
+}
+
+func TestQueryRisks(t *testing.T) {
+	risks := []*Risk{
+		newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow),
+		newTestRisk(t, "risk-2", RiskLevelCritical, RiskLevelHigh),
+		newTestRisk(t, "risk-3", RiskLevelMedium, RiskLevelMedium),
+		newTestRisk(t, "risk-4", RiskLevelHigh, RiskLevelHigh),
+		newTestRisk(t, "risk-5", RiskLevelMedium, RiskLevelHigh),
+	}
+
+	got := shared.Query(risks, shared.QueryOptions[*Risk]{
+		Filter: func(r *Risk) bool { return r.InherentScore().Value() >= 4 },
+		Less:   func(a, b *Risk) bool { return a.InherentScore().IsHigherThan(b.InherentScore()) },
+		Offset: 1,
+		Limit:  2,
+	})
+
+	if got.Total != 5 || got.Filtered != 4 {
+		t.Errorf("Total, Filtered = %d, %d, want 5, 4", got.Total, got.Filtered)
+	}
+	var ids []shared.RiskID
+	for _, r := range got.Items {
+		ids = append(ids, r.ID())
+	}
+	// Sorted by score: risk-2 (12), risk-4 (9), risk-5 (6), risk-3 (4)
+	if want := []shared.RiskID{"risk-4", "risk-5"}; !slices.Equal(ids, want) {
+		t.Errorf("Items = %v, want %v", ids, want)
+	}
+}
+
+func TestSimulateMatrixLeavesRiskUnchanged(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh)
+	r, err := r.WithResidualScore(RiskLevelMedium, RiskLevelLow)
+	if err != nil {
+		t.Fatalf("WithResidualScore() error = %v", err)
+	}
+	beforeInherent, beforeResidual := r.InherentScore(), r.ResidualScore()
+
+	strict, err := NewRiskMatrix(
+		RiskBand{Max: 1, Label: "Low"},
+		RiskBand{Max: 2, Label: "Medium"},
+		RiskBand{Max: 4, Label: "High"},
+		RiskBand{Max: 16, Label: "Critical"},
+	)
+	if err != nil {
+		t.Fatalf("NewRiskMatrix() error = %v", err)
+	}
+	inherent, residual := r.SimulateMatrix(strict)
+
+	if inherent.Label() != "Critical" || residual.Label() != "Medium" {
+		t.Errorf("SimulateMatrix() labels = %s, %s, want Critical, Medium", inherent.Label(), residual.Label())
+	}
+	if !r.InherentScore().Equal(beforeInherent) || !r.ResidualScore().Equal(beforeResidual) {
+		t.Error("SimulateMatrix() modified the risk's scores")
+	}
+	if r.InherentScore().Label() != "High" {
+		t.Errorf("inherent label = %s, want High", r.InherentScore().Label())
+	}
+}
+
+func TestRiskWithStatusTransitionMatrix(t *testing.T) {
+	statuses := map[string]RiskStatus{
+		RiskStatusIdentified: Identified{IdentifiedAt: testNow},
+		RiskStatusAssessed:   Assessed{AssessedAt: testNow, AssessorID: "user-1"},
+		RiskStatusMitigated:  Mitigated{MitigatedAt: testNow, ControlIDs: []shared.ControlID{"ctrl-1"}},
+		RiskStatusAccepted:   Accepted{AcceptedByID: "user-1", Reason: "budget", ExpiresAt: testNow.Add(24 * time.Hour)},
+		RiskStatusClosed:     Closed{ClosedAt: testNow, Resolution: "done"},
+	}
+	// paths reach each "from" status from a new (Identified) risk.
+	paths := map[string][]string{
+		RiskStatusIdentified: nil,
+		RiskStatusAssessed:   {RiskStatusAssessed},
+		RiskStatusMitigated:  {RiskStatusAssessed, RiskStatusMitigated},
+		RiskStatusAccepted:   {RiskStatusAssessed, RiskStatusAccepted},
+		RiskStatusClosed:     {RiskStatusAssessed, RiskStatusMitigated, RiskStatusClosed},
+	}
+
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{RiskStatusIdentified, RiskStatusIdentified, false},
+		{RiskStatusIdentified, RiskStatusAssessed, true},
+		{RiskStatusIdentified, RiskStatusMitigated, false},
+		{RiskStatusIdentified, RiskStatusAccepted, false},
+		{RiskStatusIdentified, RiskStatusClosed, false},
+
+		{RiskStatusAssessed, RiskStatusIdentified, false},
+		{RiskStatusAssessed, RiskStatusAssessed, false},
+		{RiskStatusAssessed, RiskStatusMitigated, true},
+		{RiskStatusAssessed, RiskStatusAccepted, true},
+		{RiskStatusAssessed, RiskStatusClosed, false},
+
+		{RiskStatusMitigated, RiskStatusIdentified, false},
+		{RiskStatusMitigated, RiskStatusAssessed, true},
+		{RiskStatusMitigated, RiskStatusMitigated, false},
+		{RiskStatusMitigated, RiskStatusAccepted, false},
+		{RiskStatusMitigated, RiskStatusClosed, true},
+
+		{RiskStatusAccepted, RiskStatusIdentified, false},
+		{RiskStatusAccepted, RiskStatusAssessed, true},
+		{RiskStatusAccepted, RiskStatusMitigated, false},
+		{RiskStatusAccepted, RiskStatusAccepted, false},
+		{RiskStatusAccepted, RiskStatusClosed, true},
+
+		{RiskStatusClosed, RiskStatusIdentified, false},
+		{RiskStatusClosed, RiskStatusAssessed, false},
+		{RiskStatusClosed, RiskStatusMitigated, false},
+		{RiskStatusClosed, RiskStatusAccepted, false},
+		{RiskStatusClosed, RiskStatusClosed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			r := newTestRisk(t, "risk-1", RiskLevelMedium, RiskLevelMedium)
+			for _, kind := range paths[tt.from] {
+				r = transitionRisk(t, r, statuses[kind])
+			}
+
+			got, err := r.WithStatusAt(testClock, statuses[tt.to])
+
+			if !tt.allowed {
+				if !errors.Is(err, shared.ErrInvalidTransition) {
+					t.Fatalf("WithStatusAt() error = %v, want INVALID_TRANSITION", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WithStatusAt() error = %v", err)
+			}
+			if RiskStatusKind(got.Status()) != tt.to {
+				t.Errorf("status = %s, want %s", RiskStatusKind(got.Status()), tt.to)
+			}
+		})
+	}
+}
+
+func TestRiskWithStatusRejectsPastAcceptanceExpiry(t *testing.T) {
+	r := transitionRisk(t, newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"})
+
+	_, err := r.WithStatusAt(testClock, Accepted{AcceptedByID: "user-1", Reason: "budget", ExpiresAt: testNow.Add(-time.Second)})
+
+	var ve shared.ValidationError
+	if !errors.As(err, &ve) || ve.Code != "INVALID_EXPIRATION" {
+		t.Fatalf("WithStatusAt() error = %v, want INVALID_EXPIRATION", err)
+	}
+}
+
+func TestRiskTransitionToRecordsEvents(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh)
+	assessedAt := testNow.Add(time.Hour)
+	mitigatedAt := testNow.Add(2 * time.Hour)
+	var events DomainEvents
+
+	assessed := Assessed{AssessedAt: assessedAt, AssessorID: "user-2"}
+	r, ev, err := r.TransitionToAt(shared.FixedClock{Time: assessedAt}, assessed)
+	if err != nil {
+		t.Fatalf("TransitionToAt(Assessed) error = %v", err)
+	}
+	events.Record(ev)
+
+	mitigated := Mitigated{MitigatedAt: mitigatedAt, ControlIDs: []shared.ControlID{"ctrl-1"}}
+	r, ev, err = r.TransitionToAt(shared.FixedClock{Time: mitigatedAt}, mitigated)
+	if err != nil {
+		t.Fatalf("TransitionToAt(Mitigated) error = %v", err)
+	}
+	events.Record(ev)
+
+	if len(events) != 2 {
+		t.Fatalf("events = %v, want 2", events)
+	}
+	first, ok := events[0].(RiskStatusChanged)
+	if !ok {
+		t.Fatalf("events[0] = %T, want RiskStatusChanged", events[0])
+	}
+	if from, ok := first.From.(Identified); first.RiskID != "risk-1" || !ok || !from.IdentifiedAt.Equal(testNow) ||
+		first.To != assessed || !first.At.Equal(assessedAt) {
+		t.Errorf("events[0] = %+v, want risk-1 Identified -> Assessed at %v", first, assessedAt)
+	}
+	second := events[1].(RiskStatusChanged)
+	if to, ok := second.To.(Mitigated); second.From != assessed || !ok || !to.MitigatedAt.Equal(mitigatedAt) ||
+		!slices.Equal(to.ControlIDs, mitigated.ControlIDs) || !second.OccurredAt().Equal(mitigatedAt) {
+		t.Errorf("events[1] = %+v, want Assessed -> Mitigated at %v", second, mitigatedAt)
+	}
+	if got, want := second.String(), "Risk risk-1: "+assessed.String()+" -> "+mitigated.String(); got != want {
+		t.Errorf("events[1].String() = %q, want %q", got, want)
+	}
+
+	if _, ev, err := r.TransitionToAt(testClock, Identified{IdentifiedAt: testNow}); err == nil || ev.From != nil || ev.To != nil {
+		t.Errorf("rejected TransitionToAt() = %+v, %v, want a zero event and an error", ev, err)
+	}
+}
+
+func TestRiskClose(t *testing.T) {
+	closedAt := testNow.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		residual   RiskLevel
+		force      bool
+		wantCode   string
+		wantForced bool
+	}{
+		{"low residual", RiskLevelLow, false, "", false},
+		{"critical residual blocked", RiskLevelCritical, false, "RESIDUAL_TOO_HIGH", false},
+		{"critical residual forced", RiskLevelCritical, true, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := mitigatedTestRisk(t, "risk-1", RiskLevelCritical, RiskLevelCritical, tt.residual, tt.residual, "ctrl-1")
+
+			got, err := r.Close("fixed", tt.force, closedAt)
+
+			if tt.wantCode != "" {
+				var ve shared.ValidationError
+				if !errors.As(err, &ve) || ve.Code != tt.wantCode {
+					t.Fatalf("Close() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			closed, ok := got.Status().(Closed)
+			if !ok {
+				t.Fatalf("status = %v, want Closed", got.Status())
+			}
+			if closed.Forced != tt.wantForced || !closed.ClosedAt.Equal(closedAt) {
+				t.Errorf("status = %+v, want Forced=%v at %s", closed, tt.wantForced, closedAt)
+			}
+			if !got.UpdatedAt().Equal(closedAt) || !got.StatusSince().Equal(closedAt) {
+				t.Errorf("UpdatedAt, StatusSince = %s, %s, want %s", got.UpdatedAt(), got.StatusSince(), closedAt)
+			}
+		})
+	}
+}
+
+func TestWithResidualScoreBoundedByInherent(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelMedium, RiskLevelHigh)
+
+	tests := []struct {
+		name               string
+		likelihood, impact RiskLevel
+		wantCode           string
+	}{
+		{"lower", RiskLevelLow, RiskLevelHigh, ""},
+		{"equal", RiskLevelMedium, RiskLevelHigh, ""},
+		{"equal value, different levels", RiskLevelHigh, RiskLevelMedium, ""},
+		{"higher", RiskLevelHigh, RiskLevelHigh, "INVALID_RESIDUAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.WithResidualScore(tt.likelihood, tt.impact)
+			if tt.wantCode != "" {
+				var ve shared.ValidationError
+				if !errors.As(err, &ve) || ve.Code != tt.wantCode {
+					t.Fatalf("WithResidualScore() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WithResidualScore() error = %v", err)
+			}
+			if got == r || got.ResidualScore().Likelihood() != tt.likelihood {
+				t.Errorf("WithResidualScore() = %v, want a new risk with the residual set", got.ResidualScore())
+			}
+			if !r.ResidualScore().Equal(r.InherentScore()) {
+				t.Error("WithResidualScore() modified the original risk")
+			}
+		})
+	}
+}
+
+func TestRiskScoreCompare(t *testing.T) {
+	highLow := CalculateRiskScore(RiskLevelHigh, RiskLevelLow)
+	lowHigh := CalculateRiskScore(RiskLevelLow, RiskLevelHigh)
+	critical := CalculateRiskScore(RiskLevelCritical, RiskLevelCritical)
+
+	if highLow.Compare(lowHigh) != 0 || lowHigh.Compare(highLow) != 0 {
+		t.Error("scores with the same value but different levels should compare equal")
+	}
+	if highLow.Equal(lowHigh) {
+		t.Error("Equal() should distinguish scores with different levels")
+	}
+	if highLow.IsHigherThan(lowHigh) {
+		t.Error("IsHigherThan() = true for scores with the same value")
+	}
+	if critical.Compare(highLow) != 1 || highLow.Compare(critical) != -1 {
+		t.Error("Compare() does not order scores by value")
+	}
+	if !critical.IsHigherThan(highLow) {
+		t.Error("IsHigherThan() = false for a higher score")
+	}
+
+	scores := []RiskScore{critical, highLow, CalculateRiskScore(RiskLevelLow, RiskLevelLow), lowHigh}
+	slices.SortStableFunc(scores, RiskScore.Compare)
+	var values []int
+	for _, s := range scores {
+		values = append(values, s.Value())
+	}
+	if want := []int{1, 3, 3, 16}; !slices.Equal(values, want) {
+		t.Errorf("sorted values = %v, want %v", values, want)
+	}
+}
+
+func TestTotalExpectedLoss(t *testing.T) {
+	usd, _ := shared.NewMoney(1000, "USD")
+	eur, _ := shared.NewMoney(500, "EUR")
+	risks := []*Risk{
+		newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow).WithExpectedLoss(usd),
+		newTestRisk(t, "risk-2", RiskLevelLow, RiskLevelLow).WithExpectedLoss(eur),
+		newTestRisk(t, "risk-3", RiskLevelLow, RiskLevelLow), // not quantified
+	}
+
+	total, err := TotalExpectedLoss(risks, map[string]float64{"EUR": 1.5}, "USD")
+	if err != nil {
+		t.Fatalf("TotalExpectedLoss() error = %v", err)
+	}
+	if want, _ := shared.NewMoney(1750, "USD"); !total.Equal(want) {
+		t.Errorf("TotalExpectedLoss() = %v %s, want 1750 USD", total.Amount(), total.Currency())
+	}
+
+	_, err = TotalExpectedLoss(risks, nil, "USD")
+	var ve shared.ValidationError
+	if !errors.As(err, &ve) || ve.Code != "MISSING_RATE" {
+		t.Errorf("TotalExpectedLoss() without rates error = %v, want MISSING_RATE", err)
+	}
+}
+
+func TestRiskLevelValuesArePersistedUnchanged(t *testing.T) {
+	levels := map[RiskLevel]int{
+		RiskLevelNegligible: 0,
+		RiskLevelLow:        1,
+		RiskLevelMedium:     2,
+		RiskLevelHigh:       3,
+		RiskLevelCritical:   4,
+	}
+	for level, want := range levels {
+		if int(level) != want {
+			t.Errorf("%s = %d, want %d", level, int(level), want)
+		}
+	}
+	if got := CalculateRiskScore(RiskLevelCritical, RiskLevelCritical); got.Value() != 16 || got.Label() != "Critical" {
+		t.Errorf("default Critical×Critical = %d %s, want 16 Critical", got.Value(), got.Label())
+	}
+}
+
+func TestNegligibleRequiresFivePointScale(t *testing.T) {
+	input := CreateRiskInput{
+		ID:         "risk-1",
+		Title:      "Typo in footer",
+		Likelihood: RiskLevelNegligible,
+		Impact:     RiskLevelLow,
+	}
+
+	_, err := NewRiskAt(testClock, input)
+	var errs shared.ValidationErrors
+	if !errors.As(err, &errs) || !errs.HasCode("INVALID_RISK_LEVEL") {
+		t.Fatalf("NewRiskAt() on the default scale error = %v, want INVALID_RISK_LEVEL", err)
+	}
+
+	input.Scoring = FivePointRiskMatrix()
+	r, err := NewRiskAt(testClock, input)
+	if err != nil {
+		t.Fatalf("NewRiskAt() on the five-point scale error = %v", err)
+	}
+	if got := r.InherentScore(); got.Value() != 2 || got.Label() != "Low" {
+		t.Errorf("Negligible×Low = %d %s, want 2 Low", got.Value(), got.Label())
+	}
+}
+
+func TestFivePointRiskMatrix(t *testing.T) {
+	m := FivePointRiskMatrix()
+
+	tests := []struct {
+		likelihood, impact RiskLevel
+		wantValue          int
+		wantLabel          string
+	}{
+		{RiskLevelNegligible, RiskLevelNegligible, 1, "Low"},
+		{RiskLevelMedium, RiskLevelMedium, 9, "Medium"},
+		{RiskLevelHigh, RiskLevelCritical, 20, "High"},
+		{RiskLevelCritical, RiskLevelCritical, 25, "Critical"},
+	}
+	for _, tt := range tests {
+		got := m.Score(tt.likelihood, tt.impact)
+		if got.Value() != tt.wantValue || got.Label() != tt.wantLabel {
+			t.Errorf("Score(%s, %s) = %d %s, want %d %s",
+				tt.likelihood, tt.impact, got.Value(), got.Label(), tt.wantValue, tt.wantLabel)
+		}
+	}
+
+	if lowest, highest := m.LevelRange(); lowest != RiskLevelNegligible || highest != RiskLevelCritical {
+		t.Errorf("LevelRange() = %s, %s, want Negligible, Critical", lowest, highest)
+	}
+}
+
+func TestRiskLevelRangeValidation(t *testing.T) {
+	tests := []struct {
+		level RiskLevel
+		valid bool
+	}{
+		{-1, false},
+		{0, false},
+		{RiskLevelLow, true},
+		{RiskLevelCritical, true},
+		{RiskLevelCritical + 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(int(tt.level)), func(t *testing.T) {
+			if got := tt.level.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+
+			_, err := NewRiskAt(testClock, CreateRiskInput{
+				ID:         "risk-1",
+				Title:      "Boundary",
+				Likelihood: tt.level,
+				Impact:     tt.level,
+			})
+			checkLevelErrors(t, "NewRiskAt", err, tt.valid)
+
+			r := newTestRisk(t, "risk-1", RiskLevelCritical, RiskLevelCritical)
+			_, err = r.WithResidualScore(tt.level, tt.level)
+			checkLevelErrors(t, "WithResidualScore", err, tt.valid)
+		})
+	}
+}
+
+// checkLevelErrors asserts that err is nil for valid levels and otherwise
+// reports INVALID_RISK_LEVEL on both likelihood and impact.
+func checkLevelErrors(t *testing.T, op string, err error, valid bool) {
+	t.Helper()
+	if valid {
+		if err != nil {
+			t.Errorf("%s() error = %v", op, err)
+		}
+		return
+	}
+	var errs shared.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("%s() error = %v, want ValidationErrors", op, err)
+	}
+	for _, field := range []string{"likelihood", "impact"} {
+		fe := errs.FieldErrors(field)
+		if len(fe) != 1 || fe[0].Code != "INVALID_RISK_LEVEL" {
+			t.Errorf("%s() %s errors = %v, want INVALID_RISK_LEVEL", op, field, fe)
+		}
+	}
+}
+
+func TestRiskUsesInjectedClock(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+	identified := r.Status().(Identified)
+	if !identified.IdentifiedAt.Equal(testNow) || !r.CreatedAt().Equal(testNow) {
+		t.Errorf("IdentifiedAt, CreatedAt = %s, %s, want %s", identified.IdentifiedAt, r.CreatedAt(), testNow)
+	}
+
+	r = transitionRisk(t, r, Assessed{AssessedAt: testNow, AssessorID: "user-1"})
+	expiry := Accepted{AcceptedByID: "user-1", Reason: "budget", ExpiresAt: testNow.Add(time.Hour)}
+
+	if _, err := r.WithStatusAt(shared.FixedClock{Time: testNow.Add(2 * time.Hour)}, expiry); !hasCode(err, "INVALID_EXPIRATION") {
+		t.Errorf("WithStatusAt() after the expiry error = %v, want INVALID_EXPIRATION", err)
+	}
+	if _, err := r.WithStatusAt(testClock, expiry); err != nil {
+		t.Errorf("WithStatusAt() before the expiry error = %v", err)
+	}
+}
+
+func TestRiskEqual(t *testing.T) {
+	input := CreateRiskInput{ID: "risk-1", Title: "Phishing", Likelihood: RiskLevelHigh, Impact: RiskLevelMedium}
+	a, _ := NewRiskAt(testClock, input)
+	b, _ := NewRiskAt(testClock, input)
+	b.updatedAt = testNow.Add(time.Hour)
+
+	if !a.Equal(b) {
+		t.Error("Equal() = false for risks differing only in updatedAt")
+	}
+	renamed := withTitle(t, a, "Vishing")
+	if a.Equal(renamed) || a.Equal(nil) {
+		t.Error("Equal() = true for different risks")
+	}
+
+	closedA := Closed{ClosedAt: testNow, Resolution: "done"}
+	closedB := Closed{ClosedAt: testNow.In(time.FixedZone("JST", 9*3600)), Resolution: "done"}
+	if !RiskStatusEqual(closedA, closedB) {
+		t.Error("RiskStatusEqual() = false for the same instant in different zones")
+	}
+	if RiskStatusEqual(closedA, Closed{ClosedAt: testNow, Resolution: "done", Forced: true}) {
+		t.Error("RiskStatusEqual() = true for statuses with different data")
+	}
+}
+
+func TestIsOpenRiskAtAcceptanceExpiry(t *testing.T) {
+	expiresAt := testNow.Add(30 * 24 * time.Hour)
+	accepted := Accepted{AcceptedByID: "user-1", Reason: "low impact", ExpiresAt: expiresAt}
+
+	tests := []struct {
+		name        string
+		status      RiskStatus
+		now         time.Time
+		wantOpen    bool
+		wantExpired bool
+	}{
+		{"identified", Identified{IdentifiedAt: testNow}, testNow, true, false},
+		{"mitigated", Mitigated{MitigatedAt: testNow}, testNow, true, false},
+		{"closed", Closed{ClosedAt: testNow, Resolution: "done"}, testNow, false, false},
+		{"accepted before expiry", accepted, expiresAt.Add(-time.Second), false, false},
+		{"accepted at expiry", accepted, expiresAt, false, false},
+		{"accepted after expiry", accepted, expiresAt.Add(time.Second), true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOpenRiskAt(tt.status, tt.now); got != tt.wantOpen {
+				t.Errorf("IsOpenRiskAt() = %v, want %v", got, tt.wantOpen)
+			}
+			if got := IsAcceptanceExpired(tt.status, tt.now); got != tt.wantExpired {
+				t.Errorf("IsAcceptanceExpired() = %v, want %v", got, tt.wantExpired)
+			}
+			if got := IsClosedRisk(tt.status); got != (tt.name == "closed") {
+				t.Errorf("IsClosedRisk() = %v", got)
+			}
+		})
+	}
+}
+
+func TestRiskRefreshAcceptance(t *testing.T) {
+	expiresAt := testNow.Add(30 * 24 * time.Hour)
+	accepted := transitionRisk(t, newTestRisk(t, "risk-1", RiskLevelMedium, RiskLevelLow),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"},
+		Accepted{AcceptedByID: "user-1", Reason: "low impact", ExpiresAt: expiresAt},
+	)
+
+	if got, changed := accepted.RefreshAcceptance(expiresAt); changed || got != accepted {
+		t.Errorf("RefreshAcceptance(ExpiresAt) = %v, %v, want the same risk unchanged", got.Status(), changed)
+	}
+
+	refreshAt := expiresAt.Add(time.Second)
+	got, changed := accepted.RefreshAcceptance(refreshAt)
+	if !changed {
+		t.Fatal("RefreshAcceptance(ExpiresAt+1s) changed = false, want true")
+	}
+	assessed, ok := got.Status().(Assessed)
+	if !ok || !assessed.AssessedAt.Equal(refreshAt) {
+		t.Errorf("Status() = %v, want Assessed at %v", got.Status(), refreshAt)
+	}
+	if _, ok := accepted.Status().(Accepted); !ok {
+		t.Errorf("original Status() = %v, want Accepted", accepted.Status())
+	}
+
+	open := newTestRisk(t, "risk-2", RiskLevelMedium, RiskLevelLow)
+	if _, changed := open.RefreshAcceptance(refreshAt); changed {
+		t.Error("RefreshAcceptance() on an Identified risk changed = true, want false")
+	}
+}
+
+func TestRiskLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		got    func(shared.Language) string
+		wantEN string
+		wantJA string
+	}{
+		{"level", RiskLevelHigh.Label, "High", "高"},
+		{"category", RiskCategoryFinancial.Label, "Financial", "財務"},
+		{"custom category", RiskCategory("Reputational").Label, "Reputational", "Reputational"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got(shared.LanguageEnglish); got != tt.wantEN {
+				t.Errorf("Label(en) = %q, want %q", got, tt.wantEN)
+			}
+			if got := tt.got(shared.LanguageJapanese); got != tt.wantJA {
+				t.Errorf("Label(ja) = %q, want %q", got, tt.wantJA)
+			}
+		})
+	}
+
+	if got := RiskLevelHigh.String(); got != "High" {
+		t.Errorf("String() = %q, want High", got)
+	}
+}
+
+func TestRiskCategoryValidation(t *testing.T) {
+	for _, c := range AllRiskCategories() {
+		t.Run(string(c), func(t *testing.T) {
+			if !c.IsValid() {
+				t.Errorf("IsValid() = false, want true")
+			}
+			if _, err := NewRiskAt(testClock, CreateRiskInput{
+				ID: "risk-1", Title: "Risk", Category: c,
+				Likelihood: RiskLevelLow, Impact: RiskLevelLow, OwnerID: "user-1",
+			}); err != nil {
+				t.Errorf("NewRiskAt() error = %v", err)
+			}
+		})
+	}
+
+	t.Run("banana", func(t *testing.T) {
+		c := RiskCategory("banana")
+		if c.IsValid() {
+			t.Error("IsValid() = true, want false")
+		}
+		_, err := NewRiskAt(testClock, CreateRiskInput{
+			ID: "risk-1", Title: "Risk", Category: c,
+			Likelihood: RiskLevelLow, Impact: RiskLevelLow, OwnerID: "user-1",
+		})
+		if !hasCode(err, "INVALID_CATEGORY") {
+			t.Errorf("NewRiskAt() error = %v, want INVALID_CATEGORY", err)
+		}
+	})
+}
+
+func TestRiskMitigatedControlIDsAreNotAliased(t *testing.T) {
+	input := []shared.ControlID{"ctrl-1", "ctrl-2"}
+	r := transitionRisk(t, newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelHigh),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"},
+		Mitigated{MitigatedAt: testNow, ControlIDs: input},
+	)
+	clone := r.Clone()
+
+	input[0] = "mutated-input"
+	r.Status().(Mitigated).ControlIDs[1] = "mutated-output"
+	clone.Status().(Mitigated).ControlIDs[0] = "mutated-clone"
+
+	want := []shared.ControlID{"ctrl-1", "ctrl-2"}
+	for name, got := range map[string]*Risk{"risk": r, "clone": clone} {
+		if ids := got.Status().(Mitigated).ControlIDs; !slices.Equal(ids, want) {
+			t.Errorf("%s ControlIDs = %v, want %v", name, ids, want)
+		}
+	}
+}
+
+// reconstructedRisk returns a copy of a valid risk with the given fields
+// overwritten, as a repository loading bad data would produce.
+func reconstructedRisk(t *testing.T, mutate func(r *Risk)) *Risk {
+	t.Helper()
+	r := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelMedium).Clone()
+	mutate(r)
+	return r
+}
+
+func TestRiskValidateReconstructed(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(r *Risk)
+		wantCode string
+	}{
+		{"valid", func(*Risk) {}, ""},
+		{"empty id", func(r *Risk) { r.id = "" }, "EMPTY_ID"},
+		{"empty title", func(r *Risk) { r.title = "" }, "REQUIRED"},
+		{"unknown category", func(r *Risk) { r.category = "banana" }, "INVALID_CATEGORY"},
+		{"residual above inherent", func(r *Risk) {
+			r.residualScore = CalculateRiskScoreWith(r.scoring, RiskLevelCritical, RiskLevelCritical)
+		}, "INVALID_RESIDUAL"},
+		{"nil status", func(r *Risk) { r.status = nil }, "REQUIRED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := reconstructedRisk(t, tt.mutate).Validate()
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if !hasCode(err, tt.wantCode) {
+				t.Errorf("Validate() error = %v, want %s", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRiskWithOwnerRejectsEmptyOwner(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow)
+	if _, err := r.WithOwner(""); !errors.Is(err, shared.ErrRequired) {
+		t.Errorf("WithOwner(\"\") error = %v, want REQUIRED", err)
+	}
+	if _, event, err := r.ReassignOwner("user-2", testNow); err != nil || event.From != "user-1" || event.To != "user-2" {
+		t.Errorf("ReassignOwner() = %+v, %v, want user-1 -> user-2", event, err)
+	}
+}
+
+func TestRiskWithTitleAndCategory(t *testing.T) {
+	r := newTestRisk(t, "risk-1", RiskLevelHigh, RiskLevelLow)
+
+	if _, err := r.WithTitle(""); !hasCode(err, "REQUIRED") {
+		t.Errorf("WithTitle(\"\") error = %v, want REQUIRED", err)
+	}
+	if _, err := r.WithCategory("banana"); !hasCode(err, "INVALID_CATEGORY") {
+		t.Errorf("WithCategory(banana) error = %v, want INVALID_CATEGORY", err)
+	}
+
+	updated, err := r.WithTitle("Phishing")
+	if err != nil {
+		t.Fatalf("WithTitle() error = %v", err)
+	}
+	if updated, err = updated.WithCategory(RiskCategoryCompliance); err != nil {
+		t.Fatalf("WithCategory() error = %v", err)
+	}
+	if updated.Title() != "Phishing" || updated.Category() != RiskCategoryCompliance {
+		t.Errorf("updated = %q/%s, want Phishing/Compliance", updated.Title(), updated.Category())
+	}
+	if r.Title() != "Risk risk-1" || r.Category() != RiskCategoryTechnical {
+		t.Errorf("original = %q/%s, want it unchanged", r.Title(), r.Category())
+	}
+	if updated.ID() != r.ID() || updated.InherentScore() != r.InherentScore() || updated.OwnerID() != r.OwnerID() {
+		t.Error("WithTitle/WithCategory changed other fields")
+	}
+}
+
+func TestParseRiskLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    RiskLevel
+		wantErr bool
+	}{
+		{"Low", RiskLevelLow, false},
+		{"medium", RiskLevelMedium, false},
+		{"HIGH", RiskLevelHigh, false},
+		{" Critical ", RiskLevelCritical, false},
+		{"1", RiskLevelLow, false},
+		{"4", RiskLevelCritical, false},
+		{"0", 0, true},
+		{"5", 0, true},
+		{"Severe", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRiskLevel(tt.in)
+			if tt.wantErr {
+				if !hasCode(err, "INVALID_RISK_LEVEL") {
+					t.Errorf("ParseRiskLevel(%q) error = %v, want INVALID_RISK_LEVEL", tt.in, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseRiskLevel(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRiskCategory(t *testing.T) {
+	for _, in := range []string{"Technical", "technical", " TECHNICAL "} {
+		if got, err := ParseRiskCategory(in); err != nil || got != RiskCategoryTechnical {
+			t.Errorf("ParseRiskCategory(%q) = %v, %v, want Technical", in, got, err)
+		}
+	}
+	if _, err := ParseRiskCategory("banana"); !hasCode(err, "INVALID_CATEGORY") {
+		t.Errorf("ParseRiskCategory(banana) error = %v, want INVALID_CATEGORY", err)
+	}
+}
+
+func TestRiskScoreNormalized(t *testing.T) {
+	fourPoint, fivePoint := DefaultRiskMatrix(), FivePointRiskMatrix()
+
+	tests := []struct {
+		name           string
+		score          RiskScore
+		wantPercentile float64
+		wantNormalized int
+	}{
+		{"4×4 min", CalculateRiskScoreWith(fourPoint, RiskLevelLow, RiskLevelLow), 0, 1},
+		{"4×4 max", CalculateRiskScoreWith(fourPoint, RiskLevelCritical, RiskLevelCritical), 100, 100},
+		{"5×5 min", CalculateRiskScoreWith(fivePoint, RiskLevelNegligible, RiskLevelNegligible), 0, 1},
+		{"5×5 max", CalculateRiskScoreWith(fivePoint, RiskLevelCritical, RiskLevelCritical), 100, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.score.Percentile(); got != tt.wantPercentile {
+				t.Errorf("Percentile() = %v, want %v", got, tt.wantPercentile)
+			}
+			if got := tt.score.Normalized(); got != tt.wantNormalized {
+				t.Errorf("Normalized() = %d, want %d", got, tt.wantNormalized)
+			}
+		})
+	}
+}
+
+func TestRiskIsAcceptanceOverdue(t *testing.T) {
+	expiresAt := testNow.Add(7 * 24 * time.Hour)
+	accepted := transitionRisk(t, newTestRisk(t, "risk-1", RiskLevelLow, RiskLevelLow),
+		Assessed{AssessedAt: testNow, AssessorID: "user-1"},
+		Accepted{AcceptedByID: "user-1", Reason: "low impact", ExpiresAt: expiresAt},
+	)
+
+	if got, ok := accepted.AcceptanceExpiresAt(); !ok || !got.Equal(expiresAt) {
+		t.Errorf("AcceptanceExpiresAt() = %v, %v, want %v", got, ok, expiresAt)
+	}
+	if accepted.IsAcceptanceOverdue(expiresAt) {
+		t.Error("IsAcceptanceOverdue(ExpiresAt) = true, want false at the exact boundary")
+	}
+	if !accepted.IsAcceptanceOverdue(expiresAt.Add(time.Nanosecond)) {
+		t.Error("IsAcceptanceOverdue(ExpiresAt+1ns) = false, want true")
+	}
+
+	open := newTestRisk(t, "risk-2", RiskLevelLow, RiskLevelLow)
+	if _, ok := open.AcceptanceExpiresAt(); ok {
+		t.Error("AcceptanceExpiresAt() ok = true for an Identified risk")
+	}
+	if open.IsAcceptanceOverdue(expiresAt.Add(time.Hour)) {
+		t.Error("IsAcceptanceOverdue() = true for an Identified risk")
+	}
+}
+
+func TestNewRiskWithActorAt(t *testing.T) {
+	input := CreateRiskInput{
+		ID: "risk-1", Title: "Phishing", Category: RiskCategoryTechnical,
+		Likelihood: RiskLevelHigh, Impact: RiskLevelMedium, OwnerID: "user-1",
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want shared.UserID
+	}{
+		{"with actor", shared.ContextWithActor(context.Background(), "auditor-7"), "auditor-7"},
+		{"without actor", context.Background(), ""},
+		{"empty actor", shared.ContextWithActor(context.Background(), ""), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRiskWithActorAt(tt.ctx, testClock, input)
+			if err != nil {
+				t.Fatalf("NewRiskWithActorAt() error = %v", err)
+			}
+			if r.CreatedBy() != tt.want {
+				t.Errorf("CreatedBy() = %q, want %q", r.CreatedBy(), tt.want)
+			}
+			if !r.CreatedAt().Equal(testNow) {
+				t.Errorf("CreatedAt() = %v, want %v", r.CreatedAt(), testNow)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Package schema emits JSON Schema documents describing the wire format of
+// the domain entities, so an API spec can be generated and diffed instead of
+// maintained by hand.
+//
+// The schemas are derived by reflection from the DTO types the entities
+// encode themselves as (see domain.RiskDTO and friends), so a field added to
+// a DTO shows up in the schema without further changes. Fields tagged
+// omitempty are optional; the format, pattern, minimum and maximum struct
+// tags are copied into the property schema.
+//
+// Sealed-interface unions such as ControlStatus and RiskStatus are described
+// as oneOf, each variant carrying a "type" discriminator whose value is the
+// variant's kind (e.g. domain.ControlStatusInProgress).
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/example/grc-domain-models/domain"
+)
+
+// Draft is the JSON Schema dialect of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// object is a JSON Schema node.
+type object = map[string]any
+
+// GenerateSchemas returns the JSON Schema for each entity, keyed by entity
+// name. The output is deterministic so it can be committed and diffed in CI.
+func GenerateSchemas() map[string][]byte {
+	schemas := map[string]object{
+		"Risk":      schemaFor(reflect.TypeOf(domain.RiskDTO{})),
+		"Control":   schemaFor(reflect.TypeOf(domain.ControlDTO{})),
+		"Evidence":  schemaFor(reflect.TypeOf(domain.EvidenceDTO{})),
+		"Framework": schemaFor(reflect.TypeOf(domain.FrameworkDTO{})),
+	}
+
+	result := make(map[string][]byte, len(schemas))
+	for name, s := range schemas {
+		s["$schema"] = Draft
+		s["title"] = name
+		data, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			// The schemas are built from plain maps, so this is a programming error.
+			panic(fmt.Sprintf("schema %s: %v", name, err))
+		}
+		result[name] = data
+	}
+	return result
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// namedTypes maps domain types with a restricted set of values to their schema.
+var namedTypes = map[reflect.Type]func() object{
+	reflect.TypeOf(domain.RiskCategory("")): func() object {
+		return enum(domain.AllRiskCategories()...)
+	},
+	reflect.TypeOf(domain.FrameworkType("")): func() object {
+		return enum(domain.AllFrameworkTypes()...)
+	},
+	reflect.TypeOf(domain.FrameworkStatus("")): func() object {
+		return enum(domain.FrameworkStatusDraft, domain.FrameworkStatusActive, domain.FrameworkStatusDeprecated)
+	},
+	reflect.TypeOf(domain.FileType("")): func() object {
+		return enum(domain.FileTypePDF, domain.FileTypeDOCX, domain.FileTypeXLSX, domain.FileTypePNG, domain.FileTypeJPG)
+	},
+	reflect.TypeOf(domain.RiskLevel(0)): func() object {
+		return object{
+			"type":    "integer",
+			"minimum": int(domain.RiskLevelNegligible),
+			"maximum": int(domain.RiskLevelCritical),
+		}
+	},
+	reflect.TypeOf(domain.TrustLevel(0)): func() object {
+		return object{
+			"type":    "integer",
+			"minimum": int(domain.TrustLevelUntrusted),
+			"maximum": int(domain.TrustLevelSystemVerified),
+		}
+	},
+}
+
+// unions maps each union DTO interface to its variants.
+var unions = map[reflect.Type]func() []any{
+	reflect.TypeOf((*domain.ControlStatusDTO)(nil)).Elem(): func() []any {
+		return anys(domain.ControlStatusDTOVariants())
+	},
+	reflect.TypeOf((*domain.RiskStatusDTO)(nil)).Elem(): func() []any {
+		return anys(domain.RiskStatusDTOVariants())
+	},
+	reflect.TypeOf((*domain.CheckResultDTO)(nil)).Elem(): func() []any {
+		return anys(domain.CheckResultDTOVariants())
+	},
+	reflect.TypeOf((*domain.EvidenceTypeDTO)(nil)).Elem(): func() []any {
+		return anys(domain.EvidenceTypeDTOVariants())
+	},
+}
+
+func anys[T any](values []T) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
+
+// schemaFor describes the JSON encoding of values of type t.
+func schemaFor(t reflect.Type) object {
+	if s, ok := namedTypes[t]; ok {
+		return s()
+	}
+	if variants, ok := unions[t]; ok {
+		return union(variants()...)
+	}
+	if t == timeType {
+		return object{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaFor(t.Elem())
+	case reflect.Slice:
+		return object{"type": "array", "items": schemaFor(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	case reflect.String:
+		return object{"type": "string"}
+	case reflect.Bool:
+		return object{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return object{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return object{"type": "number"}
+	default:
+		panic(fmt.Sprintf("schema: unsupported type %s", t))
+	}
+}
+
+// structSchema describes a struct by its json-tagged exported fields.
+func structSchema(t reflect.Type) object {
+	properties := object{}
+	var optional []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s := schemaFor(field.Type)
+		for _, key := range []string{"format", "pattern"} {
+			if v, ok := field.Tag.Lookup(key); ok {
+				s[key] = v
+			}
+		}
+		for _, key := range []string{"minimum", "maximum"} {
+			if v, ok := field.Tag.Lookup(key); ok {
+				n, err := strconv.Atoi(v)
+				if err != nil {
+					panic(fmt.Sprintf("schema: %s.%s: invalid %s tag %q", t.Name(), field.Name, key, v))
+				}
+				s[key] = n
+			}
+		}
+		properties[name] = s
+
+		if slices.Contains(strings.Split(opts, ","), "omitempty") {
+			optional = append(optional, name)
+		}
+	}
+	return record(properties, optional...)
+}
+
+// record describes an object with the given properties, all of which are
+// required unless listed in optional.
+func record(properties object, optional ...string) object {
+	var required []string
+	for name := range properties {
+		if !slices.Contains(optional, name) {
+			required = append(required, name)
+		}
+	}
+	slices.Sort(required)
+	return object{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// union describes a discriminated union over the "type" property. Each
+// variant is a DTO whose Type field holds its kind.
+func union(variants ...any) object {
+	schemas := make([]object, len(variants))
+	for i, v := range variants {
+		s := schemaFor(reflect.TypeOf(v))
+		kind := reflect.ValueOf(v).FieldByName("Type").String()
+		s["properties"].(object)["type"] = object{"const": kind}
+		schemas[i] = s
+	}
+	return object{
+		"oneOf":         schemas,
+		"discriminator": object{"propertyName": "type"},
+	}
+}
+
+func enum[T ~string](values ...T) object {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = string(v)
+	}
+	return object{"type": "string", "enum": strs}
+}
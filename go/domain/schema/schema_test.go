@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/example/grc-domain-models/domain"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestGenerateSchemasGolden(t *testing.T) {
+	schemas := GenerateSchemas()
+
+	for _, name := range []string{"Control", "Evidence", "Framework", "Risk"} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := schemas[name]
+			if !ok {
+				t.Fatalf("GenerateSchemas() has no %s schema", name)
+			}
+			golden := filepath.Join("testdata", strings.ToLower(name)+".schema.golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatalf("WriteFile() error = %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v (run with -update to create it)", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("GenerateSchemas()[%q] =\n%s\nwant\n%s", name, got, want)
+			}
+		})
+	}
+}
+
+func TestRiskStatusSchemaIsDiscriminatedUnion(t *testing.T) {
+	var risk struct {
+		Properties struct {
+			Status struct {
+				OneOf []struct {
+					Properties struct {
+						Type struct {
+							Const string `json:"const"`
+						} `json:"type"`
+					} `json:"properties"`
+				} `json:"oneOf"`
+				Discriminator struct {
+					PropertyName string `json:"propertyName"`
+				} `json:"discriminator"`
+			} `json:"status"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(GenerateSchemas()["Risk"], &risk); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	status := risk.Properties.Status
+	if status.Discriminator.PropertyName != "type" {
+		t.Errorf("status discriminator = %q, want \"type\"", status.Discriminator.PropertyName)
+	}
+	var kinds []string
+	for _, variant := range status.OneOf {
+		kinds = append(kinds, variant.Properties.Type.Const)
+	}
+	want := []string{
+		domain.RiskStatusIdentified,
+		domain.RiskStatusAssessed,
+		domain.RiskStatusMitigated,
+		domain.RiskStatusAccepted,
+		domain.RiskStatusClosed,
+	}
+	if !slices.Equal(kinds, want) {
+		t.Errorf("status oneOf type consts = %v, want %v", kinds, want)
+	}
+}
@@ -0,0 +1,17 @@
+package shared
+
+import "context"
+
+// actorKey is the context key for the acting user.
+type actorKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the user performing an operation.
+func ContextWithActor(ctx context.Context, actor UserID) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the user carried by ctx, if any.
+func ActorFromContext(ctx context.Context) (UserID, bool) {
+	actor, ok := ctx.Value(actorKey{}).(UserID)
+	return actor, ok && actor != ""
+}
@@ -0,0 +1,35 @@
+package shared
+
+import "time"
+
+// Clock provides the current time.
+// Inject a Clock to make time-dependent domain logic deterministic in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock backed by time.Now.
+type SystemClock struct{}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NowFrom returns clock.Now(), or the current time if clock is nil.
+func NowFrom(clock Clock) time.Time {
+	if clock == nil {
+		return time.Now()
+	}
+	return clock.Now()
+}
+
+// FixedClock is a Clock that always returns the same time.
+type FixedClock struct {
+	Time time.Time
+}
+
+// Now returns the fixed time.
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"slices"
+	"strings"
+)
+
+// Enum is a registry of the valid values of an enumerated type. It
+// centralizes validation, case-insensitive parsing and listing so that each
+// type only declares its values.
+type Enum[T comparable] struct {
+	values []T
+	names  map[string]T // keyed by lower-cased name or alias
+}
+
+// NewEnum creates a registry of values, each parseable by name(value).
+// Values are listed in the given order.
+func NewEnum[T comparable](name func(T) string, values ...T) *Enum[T] {
+	e := &Enum[T]{
+		values: values,
+		names:  make(map[string]T, len(values)),
+	}
+	for _, v := range values {
+		e.names[strings.ToLower(name(v))] = v
+	}
+	return e
+}
+
+// Alias registers an additional name that parses to v and returns the registry.
+func (e *Enum[T]) Alias(alias string, v T) *Enum[T] {
+	e.names[strings.ToLower(alias)] = v
+	return e
+}
+
+// Values returns the valid values in registration order.
+func (e *Enum[T]) Values() []T {
+	return slices.Clone(e.values)
+}
+
+// IsValid reports whether v is one of the registered values.
+func (e *Enum[T]) IsValid(v T) bool {
+	return slices.Contains(e.values, v)
+}
+
+// Parse returns the value registered under the name or alias s, ignoring
+// case and surrounding whitespace.
+func (e *Enum[T]) Parse(s string) (T, bool) {
+	v, ok := e.names[strings.ToLower(strings.TrimSpace(s))]
+	return v, ok
+}
@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"slices"
+	"testing"
+)
+
+type color string
+
+func TestEnum(t *testing.T) {
+	colors := NewEnum(func(c color) string { return string(c) }, "Red", "Green", "Blue").
+		Alias("Crimson", "Red")
+
+	if got := colors.Values(); !slices.Equal(got, []color{"Red", "Green", "Blue"}) {
+		t.Errorf("Values() = %v, want registration order", got)
+	}
+	colors.Values()[0] = "Purple"
+	if !colors.IsValid("Red") || colors.IsValid("Purple") {
+		t.Error("mutating Values() changed the registry")
+	}
+
+	tests := []struct {
+		in     string
+		want   color
+		wantOK bool
+	}{
+		{"Green", "Green", true},
+		{"  blue ", "Blue", true},
+		{"CRIMSON", "Red", true},
+		{"Purple", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := colors.Parse(tt.in); got != tt.want || ok != tt.wantOK {
+			t.Errorf("Parse(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if colors.IsValid("Crimson") {
+		t.Error("IsValid(alias) = true, want only registered values to be valid")
+	}
+}
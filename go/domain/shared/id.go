@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ID prefixes used by the Generate*ID functions.
+const (
+	FrameworkIDPrefix   = "fw_"
+	ControlIDPrefix     = "ctrl_"
+	EvidenceIDPrefix    = "ev_"
+	RiskIDPrefix        = "risk_"
+	UserIDPrefix        = "user_"
+	IntegrationIDPrefix = "int_"
+)
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// IDGenerator produces lexicographically sortable ULIDs from a clock and an entropy source.
+type IDGenerator struct {
+	mu      sync.Mutex
+	clock   Clock
+	entropy io.Reader
+}
+
+// NewIDGenerator creates an IDGenerator.
+// Tests can pass a FixedClock and a seeded math/rand source to get reproducible IDs.
+func NewIDGenerator(clock Clock, entropy io.Reader) *IDGenerator {
+	return &IDGenerator{clock: clock, entropy: entropy}
+}
+
+var (
+	defaultIDGeneratorMu sync.RWMutex
+	defaultIDGenerator   = NewIDGenerator(SystemClock{}, rand.Reader)
+)
+
+// SetDefaultIDGenerator replaces the generator used by NewID and the Generate*ID
+// functions, returning the previous one so that tests can restore it.
+func SetDefaultIDGenerator(g *IDGenerator) *IDGenerator {
+	defaultIDGeneratorMu.Lock()
+	defer defaultIDGeneratorMu.Unlock()
+	previous := defaultIDGenerator
+	defaultIDGenerator = g
+	return previous
+}
+
+// NewULID returns a new 26-character ULID.
+// It panics if the entropy source fails.
+func (g *IDGenerator) NewULID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var b [16]byte
+	ms := uint64(g.clock.Now().UnixMilli())
+	for i := 0; i < 6; i++ {
+		b[i] = byte(ms >> (40 - 8*i))
+	}
+	if _, err := io.ReadFull(g.entropy, b[6:]); err != nil {
+		panic(fmt.Sprintf("failed to read ULID entropy: %v", err))
+	}
+	return encodeULID(b)
+}
+
+// encodeULID encodes 128 bits as 26 Crockford base32 characters.
+func encodeULID(b [16]byte) string {
+	var out [26]byte
+	// The first character carries the top 3 bits; the rest carry 5 bits each.
+	for i := 0; i < 26; i++ {
+		bit := 128 - (26-i)*5 // position of the most significant bit of this character
+		var v byte
+		for j := 0; j < 5; j++ {
+			pos := bit + j
+			if pos < 0 {
+				continue
+			}
+			v = v<<1 | (b[pos/8]>>(7-pos%8))&1
+		}
+		out[i] = crockfordAlphabet[v]
+	}
+	return string(out[:])
+}
+
+// NewID returns prefix followed by a new ULID from the default generator.
+func NewID(prefix string) string {
+	defaultIDGeneratorMu.RLock()
+	g := defaultIDGenerator
+	defaultIDGeneratorMu.RUnlock()
+	return prefix + g.NewULID()
+}
+
+// GenerateFrameworkID returns a new FrameworkID such as "fw_01H...".
+func GenerateFrameworkID() FrameworkID { return FrameworkID(NewID(FrameworkIDPrefix)) }
+
+// GenerateControlID returns a new ControlID such as "ctrl_01H...".
+func GenerateControlID() ControlID { return ControlID(NewID(ControlIDPrefix)) }
+
+// GenerateEvidenceID returns a new EvidenceID such as "ev_01H...".
+func GenerateEvidenceID() EvidenceID { return EvidenceID(NewID(EvidenceIDPrefix)) }
+
+// GenerateRiskID returns a new RiskID such as "risk_01H...".
+func GenerateRiskID() RiskID { return RiskID(NewID(RiskIDPrefix)) }
+
+// GenerateUserID returns a new UserID such as "user_01H...".
+func GenerateUserID() UserID { return UserID(NewID(UserIDPrefix)) }
+
+// GenerateIntegrationID returns a new IntegrationID such as "int_01H...".
+func GenerateIntegrationID() IntegrationID { return IntegrationID(NewID(IntegrationIDPrefix)) }
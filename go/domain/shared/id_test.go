@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSeededIDGeneratorIsReproducible(t *testing.T) {
+	clock := FixedClock{Time: time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)}
+	a := NewIDGenerator(clock, rand.New(rand.NewSource(42)))
+	b := NewIDGenerator(clock, rand.New(rand.NewSource(42)))
+
+	first, second := a.NewULID(), b.NewULID()
+	if first != second {
+		t.Errorf("seeded generators disagree: %s != %s", first, second)
+	}
+	if !IsValidULID(first) {
+		t.Errorf("NewULID() = %q, not a valid ULID", first)
+	}
+	if a.NewULID() == first {
+		t.Error("consecutive ULIDs are equal")
+	}
+}
+
+func TestULIDsSortByTime(t *testing.T) {
+	entropy := rand.New(rand.NewSource(1))
+	earlier := NewIDGenerator(FixedClock{Time: time.UnixMilli(1_700_000_000_000)}, entropy).NewULID()
+	later := NewIDGenerator(FixedClock{Time: time.UnixMilli(1_700_000_000_001)}, entropy).NewULID()
+
+	if earlier >= later {
+		t.Errorf("ULIDs do not sort by time: %s >= %s", earlier, later)
+	}
+}
+
+func TestGenerateRiskIDUsesDefaultGenerator(t *testing.T) {
+	seeded := NewIDGenerator(FixedClock{Time: time.UnixMilli(0)}, rand.New(rand.NewSource(7)))
+	previous := SetDefaultIDGenerator(seeded)
+	defer SetDefaultIDGenerator(previous)
+
+	id := GenerateRiskID()
+
+	if !strings.HasPrefix(string(id), RiskIDPrefix) {
+		t.Errorf("GenerateRiskID() = %q, want prefix %q", id, RiskIDPrefix)
+	}
+	if _, err := NewRiskIDWithPrefix(string(id), RiskIDPrefix); err != nil {
+		t.Errorf("GenerateRiskID() = %q, rejected by NewRiskIDWithPrefix: %v", id, err)
+	}
+	if !strings.HasPrefix(string(id), RiskIDPrefix+"0000000000") {
+		t.Errorf("GenerateRiskID() = %q, want the zero timestamp from the seeded clock", id)
+	}
+}
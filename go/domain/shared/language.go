@@ -0,0 +1,12 @@
+package shared
+
+// Language identifies the language of user-facing labels.
+type Language string
+
+const (
+	LanguageEnglish  Language = "en"
+	LanguageJapanese Language = "ja"
+)
+
+// DefaultLanguage is used by label functions that take no language.
+const DefaultLanguage = LanguageJapanese
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MessageCatalog holds message templates keyed by code and language.
+// Templates use fmt verbs; explicit argument indexes such as %[2]s let a
+// language reorder the arguments.
+type MessageCatalog struct {
+	mu       sync.RWMutex
+	messages map[Language]map[string]string
+	fallback Language
+}
+
+// NewMessageCatalog creates an empty catalog that falls back to the given
+// language when a message is missing.
+func NewMessageCatalog(fallback Language) *MessageCatalog {
+	return &MessageCatalog{
+		messages: make(map[Language]map[string]string),
+		fallback: fallback,
+	}
+}
+
+// Register adds or replaces templates for a language.
+func (c *MessageCatalog) Register(lang Language, messages map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.messages[lang] == nil {
+		c.messages[lang] = make(map[string]string, len(messages))
+	}
+	for code, template := range messages {
+		c.messages[lang][code] = template
+	}
+}
+
+// Template returns the template for a code in the given language,
+// falling back to the catalog's fallback language.
+func (c *MessageCatalog) Template(code string, lang Language) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if t, ok := c.messages[lang][code]; ok {
+		return t, true
+	}
+	t, ok := c.messages[c.fallback][code]
+	return t, ok
+}
+
+// Format renders the template for a code with the given arguments.
+// If no template exists the code itself is returned.
+func (c *MessageCatalog) Format(lang Language, code string, args ...any) string {
+	t, ok := c.Template(code, lang)
+	if !ok {
+		return code
+	}
+	return fmt.Sprintf(t, args...)
+}
+
+// DefaultCatalog is the catalog used by the domain label functions.
+var DefaultCatalog = NewMessageCatalog(DefaultLanguage)
+
+// RegisterMessages adds or replaces templates for a language in DefaultCatalog,
+// allowing downstream code to add locales.
+func RegisterMessages(lang Language, messages map[string]string) {
+	DefaultCatalog.Register(lang, messages)
+}
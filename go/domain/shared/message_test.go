@@ -0,0 +1,25 @@
+package shared
+
+import "testing"
+
+func TestMessageCatalogFallback(t *testing.T) {
+	c := NewMessageCatalog(LanguageJapanese)
+	c.Register(LanguageJapanese, map[string]string{"greeting": "こんにちは %[1]s", "farewell": "さようなら %[1]s"})
+	c.Register(LanguageEnglish, map[string]string{"greeting": "Hello %[1]s"})
+
+	tests := []struct {
+		lang Language
+		code string
+		want string
+	}{
+		{LanguageEnglish, "greeting", "Hello Ada"},
+		{LanguageJapanese, "greeting", "こんにちは Ada"},
+		{LanguageEnglish, "farewell", "さようなら Ada"},
+		{LanguageEnglish, "missing", "missing"},
+	}
+	for _, tt := range tests {
+		if got := c.Format(tt.lang, tt.code, "Ada"); got != tt.want {
+			t.Errorf("Format(%s, %s) = %q, want %q", tt.lang, tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package shared
+
+import "sort"
+
+// QueryOptions describes how to filter, sort and paginate a list.
+// A nil Filter keeps every item, a nil Less keeps the original order,
+// and a Limit of zero or less returns every item after Offset.
+type QueryOptions[T any] struct {
+	Filter func(T) bool
+	Less   func(a, b T) bool
+	Offset int
+	Limit  int
+}
+
+// QueryResult holds one page of items along with the counts needed for paging.
+type QueryResult[T any] struct {
+	Items    []T
+	Total    int // number of items before filtering
+	Filtered int // number of items after filtering, before pagination
+}
+
+// Query filters, sorts and paginates items without modifying the input slice.
+func Query[T any](items []T, opts QueryOptions[T]) QueryResult[T] {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if opts.Filter == nil || opts.Filter(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	if opts.Less != nil {
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return opts.Less(filtered[i], filtered[j])
+		})
+	}
+
+	start := opts.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	end := len(filtered)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+	}
+
+	page := make([]T, end-start)
+	copy(page, filtered[start:end])
+
+	return QueryResult[T]{
+		Items:    page,
+		Total:    len(items),
+		Filtered: len(filtered),
+	}
+}
@@ -2,6 +2,7 @@
 package shared
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -59,6 +60,27 @@ func (r Result[T]) Error() error {
 	return r.err
 }
 
+// Filter turns an Ok into Err(err) if pred returns false for its value.
+// An Err is returned unchanged without calling pred.
+func (r Result[T]) Filter(pred func(T) bool, err error) Result[T] {
+	if !r.ok || pred(r.value) {
+		return r
+	}
+	return Err[T](err)
+}
+
+// Ensure turns an Ok into an Err if check returns a non-nil error for its value.
+// An Err is returned unchanged without calling check.
+func (r Result[T]) Ensure(check func(T) error) Result[T] {
+	if !r.ok {
+		return r
+	}
+	if err := check(r.value); err != nil {
+		return Err[T](err)
+	}
+	return r
+}
+
 // Match applies the appropriate function based on the Result state.
 func Match[T any, U any](r Result[T], onOk func(T) U, onErr func(error) U) U {
 	if r.ok {
@@ -67,17 +89,158 @@ func Match[T any, U any](r Result[T], onOk func(T) U, onErr func(error) U) U {
 	return onErr(r.err)
 }
 
+// Map2 combines two independent Results with f. If either is an Err, the
+// first error is returned, checking a before b.
+func Map2[A, B, C any](a Result[A], b Result[B], f func(A, B) C) Result[C] {
+	if !a.ok {
+		return Err[C](a.err)
+	}
+	if !b.ok {
+		return Err[C](b.err)
+	}
+	return Ok(f(a.value, b.value))
+}
+
+// Pair holds two values combined by Zip.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+// Zip combines two Results into a Result of both values, with the same
+// error precedence as Map2.
+func Zip[A, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	return Map2(a, b, func(x A, y B) Pair[A, B] { return Pair[A, B]{A: x, B: y} })
+}
+
+// Collect converts a slice of Results into a Result of slice.
+// It returns Ok with all values if every element succeeded,
+// otherwise the first error encountered.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if !r.ok {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
+
+// CollectErrors is like Collect but aggregates every error into a
+// ValidationErrors instead of stopping at the first one.
+// Each field is prefixed with the index of the failed element (e.g. "[2].title").
+func CollectErrors[T any](rs []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(rs))
+	var errs ValidationErrors
+	for i, r := range rs {
+		if r.ok {
+			values = append(values, r.value)
+			continue
+		}
+		var nested ValidationErrors
+		nested.AddError("", r.err)
+		if len(nested) == 0 {
+			// Err(nil) still failed; never report it as Ok
+			nested.Add("", "Result failed without an error", string(ErrInternal))
+		}
+		prefix := fmt.Sprintf("[%d]", i)
+		for _, ve := range nested {
+			ve.Field = prefixField(prefix, ve.Field)
+			errs = append(errs, ve)
+		}
+	}
+	if errs.HasErrors() {
+		return Err[[]T](errs)
+	}
+	return Ok(values)
+}
+
+func prefixField(prefix, field string) string {
+	if field == "" {
+		return prefix
+	}
+	return prefix + "." + field
+}
+
+// Severity indicates whether a validation issue blocks the operation.
+// The zero value is SeverityError.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "unknown"
+	}
+}
+
 // ValidationError represents a domain validation error.
 type ValidationError struct {
-	Field   string
-	Message string
-	Code    string
+	Field    string
+	Message  string
+	Code     string
+	Severity Severity
 }
 
 func (e ValidationError) Error() string {
 	return fmt.Sprintf("[%s] %s: %s", e.Code, e.Field, e.Message)
 }
 
+// Is reports whether target matches this error's code.
+// It allows errors.Is(err, shared.ErrRequired) to match any ValidationError
+// with the code "REQUIRED", including one nested in ValidationErrors.
+func (e ValidationError) Is(target error) bool {
+	if code, ok := target.(ErrorCode); ok {
+		return e.Code == string(code)
+	}
+	return false
+}
+
+// validationErrorJSON is the wire form of a ValidationError.
+type validationErrorJSON struct {
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+}
+
+// MarshalJSON encodes the error as {"field", "message", "code", "severity"}.
+func (e ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(validationErrorJSON{
+		Field:    e.Field,
+		Message:  e.Message,
+		Code:     e.Code,
+		Severity: e.Severity.String(),
+	})
+}
+
+// ErrorCode is a sentinel error matching ValidationErrors by their Code.
+type ErrorCode string
+
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+// Sentinel error codes for use with errors.Is.
+const (
+	ErrRequired          ErrorCode = "REQUIRED"
+	ErrEmptyID           ErrorCode = "EMPTY_ID"
+	ErrInvalidTransition ErrorCode = "INVALID_TRANSITION"
+	ErrInternal          ErrorCode = "INTERNAL"
+)
+
 // NewValidationError creates a new ValidationError.
 func NewValidationError(field, message, code string) ValidationError {
 	return ValidationError{
@@ -87,6 +250,17 @@ func NewValidationError(field, message, code string) ValidationError {
 	}
 }
 
+// NewValidationWarning creates a ValidationError with SeverityWarning,
+// for issues worth showing that should not block the operation.
+func NewValidationWarning(field, message, code string) ValidationError {
+	return ValidationError{
+		Field:    field,
+		Message:  message,
+		Code:     code,
+		Severity: SeverityWarning,
+	}
+}
+
 // ValidationErrors is a collection of validation errors.
 type ValidationErrors []ValidationError
 
@@ -106,11 +280,111 @@ func (e *ValidationErrors) Add(field, message, code string) {
 	*e = append(*e, NewValidationError(field, message, code))
 }
 
-// HasErrors returns true if there are any validation errors.
+// AddError appends err to the collection. ValidationError and ValidationErrors
+// are appended as-is; any other error is recorded under the given field
+// with the code "INTERNAL" so that it is never silently dropped.
+func (e *ValidationErrors) AddError(field string, err error) {
+	switch v := err.(type) {
+	case nil:
+		return
+	case ValidationError:
+		*e = append(*e, v)
+	case ValidationErrors:
+		*e = append(*e, v...)
+	default:
+		e.Add(field, err.Error(), string(ErrInternal))
+	}
+}
+
+// AddWarning appends a validation warning to the collection.
+func (e *ValidationErrors) AddWarning(field, message, code string) {
+	*e = append(*e, NewValidationWarning(field, message, code))
+}
+
+// HasErrors returns true if there are any validation errors, regardless of severity.
 func (e ValidationErrors) HasErrors() bool {
 	return len(e) > 0
 }
 
+// HasBlocking returns true if any entry has SeverityError.
+func (e ValidationErrors) HasBlocking() bool {
+	for _, err := range e {
+		if err.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the contained errors so that errors.Is and errors.As
+// can inspect each ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// HasCode returns true if any validation error has the given code.
+func (e ValidationErrors) HasCode(code string) bool {
+	for _, err := range e {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldErrors returns the validation errors for the given field.
+func (e ValidationErrors) FieldErrors(field string) []ValidationError {
+	var result []ValidationError
+	for _, err := range e {
+		if err.Field == field {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// ByField groups the errors by field, preserving their order within each field.
+func (e ValidationErrors) ByField() map[string][]ValidationError {
+	result := make(map[string][]ValidationError)
+	for _, err := range e {
+		result[err.Field] = append(result[err.Field], err)
+	}
+	return result
+}
+
+// Fields returns the distinct fields with errors, in first-seen order.
+func (e ValidationErrors) Fields() []string {
+	seen := make(map[string]bool, len(e))
+	var fields []string
+	for _, err := range e {
+		if !seen[err.Field] {
+			seen[err.Field] = true
+			fields = append(fields, err.Field)
+		}
+	}
+	return fields
+}
+
+// MarshalJSON encodes the errors as an array of ValidationError objects in
+// the order they were added. An empty collection encodes as [].
+func (e ValidationErrors) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]ValidationError(e))
+}
+
+// ErrorResponse wraps the errors in an {"errors": [...]} JSON body for API responses.
+func (e ValidationErrors) ErrorResponse() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors ValidationErrors `json:"errors"`
+	}{e})
+}
+
 // ToError converts to error interface, returns nil if no errors.
 func (e ValidationErrors) ToError() error {
 	if len(e) == 0 {
@@ -0,0 +1,263 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+
+	tests := []struct {
+		name    string
+		results []Result[int]
+		want    []int
+		wantErr error
+	}{
+		{"empty", nil, []int{}, nil},
+		{"all ok", []Result[int]{Ok(1), Ok(2), Ok(3)}, []int{1, 2, 3}, nil},
+		{"first error wins", []Result[int]{Ok(1), Err[int](errFirst), Err[int](errSecond)}, nil, errFirst},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Collect(tt.results)
+			if tt.wantErr != nil {
+				if !errors.Is(got.Error(), tt.wantErr) {
+					t.Fatalf("Collect() error = %v, want %v", got.Error(), tt.wantErr)
+				}
+				return
+			}
+			if got.IsErr() {
+				t.Fatalf("Collect() error = %v", got.Error())
+			}
+			if !slices.Equal(got.Unwrap(), tt.want) {
+				t.Errorf("Collect() = %v, want %v", got.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsIsByCode(t *testing.T) {
+	var errs ValidationErrors
+	errs.Add("title", "Title is required", "REQUIRED")
+	errs.Add("id", "ID is empty", "EMPTY_ID")
+	var err error = errs
+
+	if !errors.Is(err, ErrRequired) {
+		t.Error("errors.Is(err, ErrRequired) = false, want true")
+	}
+	if !errors.Is(err, ErrEmptyID) {
+		t.Error("errors.Is(err, ErrEmptyID) = false, want true")
+	}
+	if errors.Is(err, ErrInvalidTransition) {
+		t.Error("errors.Is(err, ErrInvalidTransition) = true, want false")
+	}
+
+	var ve ValidationError
+	if !errors.As(err, &ve) || ve.Field != "title" {
+		t.Errorf("errors.As() = %v, want the title error", ve)
+	}
+	if !errs.HasCode("EMPTY_ID") || errs.HasCode("INTERNAL") {
+		t.Error("HasCode() does not match the added codes")
+	}
+	if got := errs.FieldErrors("id"); len(got) != 1 || got[0].Code != "EMPTY_ID" {
+		t.Errorf("FieldErrors(id) = %v, want one EMPTY_ID error", got)
+	}
+}
+
+func TestAddErrorRecordsUnexpectedErrorsAsInternal(t *testing.T) {
+	var errs ValidationErrors
+	errs.AddError("id", errors.New("entropy source failed"))
+	errs.AddError("id", nil)
+
+	if len(errs) != 1 {
+		t.Fatalf("AddError() recorded %d errors, want 1", len(errs))
+	}
+	if errs[0].Field != "id" || errs[0].Code != string(ErrInternal) {
+		t.Errorf("AddError() = %v, want INTERNAL on id", errs[0])
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	var nested ValidationErrors
+	nested.Add("title", "Title is required", "REQUIRED")
+
+	got := CollectErrors([]Result[int]{
+		Ok(1),
+		Err[int](nested),
+		Err[int](errors.New("boom")),
+		Err[int](nil),
+	})
+
+	var errs ValidationErrors
+	if !errors.As(got.Error(), &errs) {
+		t.Fatalf("CollectErrors() error = %v, want ValidationErrors", got.Error())
+	}
+	want := []struct{ field, code string }{
+		{"[1].title", "REQUIRED"},
+		{"[2]", "INTERNAL"},
+		{"[3]", "INTERNAL"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("CollectErrors() = %v, want %d errors", errs, len(want))
+	}
+	for i, w := range want {
+		if errs[i].Field != w.field || errs[i].Code != w.code {
+			t.Errorf("errs[%d] = %s/%s, want %s/%s", i, errs[i].Field, errs[i].Code, w.field, w.code)
+		}
+	}
+}
+
+func TestFilterAndEnsure(t *testing.T) {
+	errOdd := errors.New("odd")
+	errEarlier := errors.New("earlier")
+	isEven := func(n int) bool { return n%2 == 0 }
+	checkEven := func(n int) error {
+		if !isEven(n) {
+			return errOdd
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		in      Result[int]
+		wantErr error
+	}{
+		{"ok passes", Ok(2), nil},
+		{"ok fails", Ok(3), errOdd},
+		{"err unchanged", Err[int](errEarlier), errEarlier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, got := range map[string]Result[int]{
+				"Filter": tt.in.Filter(isEven, errOdd),
+				"Ensure": tt.in.Ensure(checkEven),
+			} {
+				if got.Error() != tt.wantErr {
+					t.Errorf("%s() error = %v, want %v", name, got.Error(), tt.wantErr)
+				}
+				if tt.wantErr == nil && got.Unwrap() != 2 {
+					t.Errorf("%s() = %d, want 2", name, got.Unwrap())
+				}
+			}
+		})
+	}
+
+	called := false
+	Err[int](errEarlier).Filter(func(int) bool { called = true; return true }, errOdd)
+	Err[int](errEarlier).Ensure(func(int) error { called = true; return nil })
+	if called {
+		t.Error("predicate was called on an Err")
+	}
+}
+
+func TestMap2AndZipErrorPrecedence(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	join := func(a int, b string) string { return fmt.Sprintf("%d-%s", a, b) }
+
+	tests := []struct {
+		name    string
+		a       Result[int]
+		b       Result[string]
+		wantErr error
+	}{
+		{"both ok", Ok(1), Ok("x"), nil},
+		{"a fails", Err[int](errA), Ok("x"), errA},
+		{"b fails", Ok(1), Err[string](errB), errB},
+		{"both fail", Err[int](errA), Err[string](errB), errA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapped := Map2(tt.a, tt.b, join)
+			zipped := Zip(tt.a, tt.b)
+			if mapped.Error() != tt.wantErr || zipped.Error() != tt.wantErr {
+				t.Fatalf("Map2() error = %v, Zip() error = %v, want %v", mapped.Error(), zipped.Error(), tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := mapped.Unwrap(); got != "1-x" {
+				t.Errorf("Map2() = %q, want 1-x", got)
+			}
+			if got := zipped.Unwrap(); got != (Pair[int, string]{A: 1, B: "x"}) {
+				t.Errorf("Zip() = %+v, want {1 x}", got)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsJSON(t *testing.T) {
+	var errs ValidationErrors
+	errs.Add("title", "Title is required", "REQUIRED")
+	errs.AddWarning("description", "Description is empty", "EMPTY_DESCRIPTION")
+
+	got, err := errs.ErrorResponse()
+	if err != nil {
+		t.Fatalf("ErrorResponse() error = %v", err)
+	}
+	want := `{"errors":[` +
+		`{"field":"title","message":"Title is required","code":"REQUIRED","severity":"error"},` +
+		`{"field":"description","message":"Description is empty","code":"EMPTY_DESCRIPTION","severity":"warning"}]}`
+	if string(got) != want {
+		t.Errorf("ErrorResponse() =\n%s\nwant\n%s", got, want)
+	}
+
+	empty, err := json.Marshal(ValidationErrors(nil))
+	if err != nil || string(empty) != "[]" {
+		t.Errorf("json.Marshal(nil) = %s, %v, want []", empty, err)
+	}
+}
+
+func TestValidationErrorsByField(t *testing.T) {
+	var errs ValidationErrors
+	errs.Add("title", "Title is required", "REQUIRED")
+	errs.Add("id", "ID is empty", "EMPTY_ID")
+	errs.Add("title", "Title is too long", "TOO_LONG")
+
+	if got := errs.Fields(); !slices.Equal(got, []string{"title", "id"}) {
+		t.Errorf("Fields() = %v, want [title id]", got)
+	}
+
+	byField := errs.ByField()
+	var titleCodes []string
+	for _, e := range byField["title"] {
+		titleCodes = append(titleCodes, e.Code)
+	}
+	if !slices.Equal(titleCodes, []string{"REQUIRED", "TOO_LONG"}) {
+		t.Errorf("ByField()[title] codes = %v, want [REQUIRED TOO_LONG]", titleCodes)
+	}
+	if len(byField) != 2 || len(byField["id"]) != 1 {
+		t.Errorf("ByField() = %v, want two fields with one id error", byField)
+	}
+}
+
+func TestValidationErrorsSeverity(t *testing.T) {
+	if got := NewValidationError("title", "Title is required", "REQUIRED").Severity; got != SeverityError {
+		t.Errorf("NewValidationError() severity = %s, want error", got)
+	}
+	if got := NewValidationWarning("description", "Description is empty", "EMPTY").Severity; got != SeverityWarning {
+		t.Errorf("NewValidationWarning() severity = %s, want warning", got)
+	}
+
+	var warnings ValidationErrors
+	warnings.AddWarning("description", "Description is empty", "EMPTY")
+	warnings = append(warnings, ValidationError{Field: "owner", Message: "Owner is on leave", Code: "OWNER_AWAY", Severity: SeverityInfo})
+	if !warnings.HasErrors() || warnings.HasBlocking() {
+		t.Errorf("warnings only: HasErrors() = %v, HasBlocking() = %v, want true, false",
+			warnings.HasErrors(), warnings.HasBlocking())
+	}
+
+	mixed := append(warnings, NewValidationError("title", "Title is required", "REQUIRED"))
+	if !mixed.HasBlocking() {
+		t.Error("mixed: HasBlocking() = false, want true")
+	}
+}
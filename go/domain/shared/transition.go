@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TransitionTable holds the allowed from→to edges of a state machine.
+// Build it once with NewTransitionTable, Allow and Deny, then treat it as read-only.
+type TransitionTable[S comparable] struct {
+	edges  map[S][]S
+	denied map[[2]S]string // rejection message per denied edge
+}
+
+// NewTransitionTable creates an empty TransitionTable.
+func NewTransitionTable[S comparable]() *TransitionTable[S] {
+	return &TransitionTable[S]{edges: make(map[S][]S), denied: make(map[[2]S]string)}
+}
+
+// Allow registers transitions from one state to each of the given states.
+// It returns the table so that calls can be chained.
+func (t *TransitionTable[S]) Allow(from S, to ...S) *TransitionTable[S] {
+	for _, s := range to {
+		if !t.allowed(from, s) {
+			t.edges[from] = append(t.edges[from], s)
+		}
+	}
+	return t
+}
+
+// Deny removes the transition from one state to another, if allowed, and
+// sets the message CanTransition reports when it is attempted.
+// It returns the table so that calls can be chained.
+func (t *TransitionTable[S]) Deny(from, to S, message string) *TransitionTable[S] {
+	t.edges[from] = slices.DeleteFunc(t.edges[from], func(s S) bool { return s == to })
+	t.denied[[2]S{from, to}] = message
+	return t
+}
+
+// CanTransition returns an INVALID_TRANSITION ValidationError if the
+// transition from one state to another has not been registered. The error
+// carries the Deny message of the edge, if any, or a generic one.
+func (t *TransitionTable[S]) CanTransition(from, to S) error {
+	if t.allowed(from, to) {
+		return nil
+	}
+	message, ok := t.denied[[2]S{from, to}]
+	if !ok {
+		message = fmt.Sprintf("Cannot transition from %v to %v", from, to)
+	}
+	return NewValidationError("status", message, string(ErrInvalidTransition))
+}
+
+// AllowedFrom returns the states reachable from the given state,
+// in the order they were registered.
+func (t *TransitionTable[S]) AllowedFrom(from S) []S {
+	result := make([]S, len(t.edges[from]))
+	copy(result, t.edges[from])
+	return result
+}
+
+func (t *TransitionTable[S]) allowed(from, to S) bool {
+	for _, s := range t.edges[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
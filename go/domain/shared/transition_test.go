@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTransitionTable(t *testing.T) {
+	table := NewTransitionTable[string]().
+		Allow("draft", "active", "archived").
+		Allow("active", "archived").
+		Deny("draft", "archived", "Drafts are deleted, not archived")
+
+	tests := []struct {
+		from, to    string
+		wantErr     bool
+		wantMessage string
+	}{
+		{"draft", "active", false, ""},
+		{"active", "archived", false, ""},
+		{"draft", "archived", true, "Drafts are deleted, not archived"},
+		{"archived", "active", true, "Cannot transition from archived to active"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			err := table.CanTransition(tt.from, tt.to)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("CanTransition() error = %v, want nil", err)
+				}
+				return
+			}
+			var ve ValidationError
+			if !errors.As(err, &ve) || !errors.Is(err, ErrInvalidTransition) {
+				t.Fatalf("CanTransition() error = %v, want INVALID_TRANSITION", err)
+			}
+			if ve.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", ve.Message, tt.wantMessage)
+			}
+		})
+	}
+
+	if got := table.AllowedFrom("draft"); !slices.Equal(got, []string{"active"}) {
+		t.Errorf("AllowedFrom(draft) = %v, want [active]", got)
+	}
+}
@@ -1,7 +1,11 @@
 package shared
 
 import (
+	"fmt"
+	"math"
 	"net/url"
+	"regexp"
+	"strings"
 )
 
 // ID types - using distinct types for type safety
@@ -73,6 +77,88 @@ func NewIntegrationID(value string) (IntegrationID, error) {
 	return IntegrationID(value), nil
 }
 
+var (
+	ulidPattern = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// IsValidULID returns true if value is a canonical 26-character ULID.
+func IsValidULID(value string) bool {
+	return ulidPattern.MatchString(value)
+}
+
+// IsValidUUID returns true if value is a hyphenated UUID.
+func IsValidUUID(value string) bool {
+	return uuidPattern.MatchString(value)
+}
+
+// validatePrefixedID checks that value is prefix followed by a ULID or UUID.
+func validatePrefixedID(typeName, value, prefix string) error {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok || !(IsValidULID(rest) || IsValidUUID(rest)) {
+		return NewValidationError(
+			"id",
+			fmt.Sprintf("%s must be %q followed by a ULID or UUID", typeName, prefix),
+			"INVALID_ID_FORMAT",
+		)
+	}
+	return nil
+}
+
+// NewFrameworkIDWithPrefix creates a FrameworkID that must be prefix followed
+// by a ULID or UUID, e.g. "fw_01H...".
+func NewFrameworkIDWithPrefix(value, prefix string) (FrameworkID, error) {
+	if err := validatePrefixedID("FrameworkID", value, prefix); err != nil {
+		return "", err
+	}
+	return FrameworkID(value), nil
+}
+
+// NewControlIDWithPrefix creates a ControlID that must be prefix followed
+// by a ULID or UUID, e.g. "ctrl_01H...".
+func NewControlIDWithPrefix(value, prefix string) (ControlID, error) {
+	if err := validatePrefixedID("ControlID", value, prefix); err != nil {
+		return "", err
+	}
+	return ControlID(value), nil
+}
+
+// NewEvidenceIDWithPrefix creates an EvidenceID that must be prefix followed
+// by a ULID or UUID, e.g. "ev_01H...".
+func NewEvidenceIDWithPrefix(value, prefix string) (EvidenceID, error) {
+	if err := validatePrefixedID("EvidenceID", value, prefix); err != nil {
+		return "", err
+	}
+	return EvidenceID(value), nil
+}
+
+// NewRiskIDWithPrefix creates a RiskID that must be prefix followed
+// by a ULID or UUID, e.g. "risk_01H...".
+func NewRiskIDWithPrefix(value, prefix string) (RiskID, error) {
+	if err := validatePrefixedID("RiskID", value, prefix); err != nil {
+		return "", err
+	}
+	return RiskID(value), nil
+}
+
+// NewUserIDWithPrefix creates a UserID that must be prefix followed
+// by a ULID or UUID, e.g. "user_01H...".
+func NewUserIDWithPrefix(value, prefix string) (UserID, error) {
+	if err := validatePrefixedID("UserID", value, prefix); err != nil {
+		return "", err
+	}
+	return UserID(value), nil
+}
+
+// NewIntegrationIDWithPrefix creates an IntegrationID that must be prefix followed
+// by a ULID or UUID, e.g. "int_01H...".
+func NewIntegrationIDWithPrefix(value, prefix string) (IntegrationID, error) {
+	if err := validatePrefixedID("IntegrationID", value, prefix); err != nil {
+		return "", err
+	}
+	return IntegrationID(value), nil
+}
+
 // Percentage represents a value between 0 and 100.
 // The struct is immutable - fields are unexported.
 type Percentage struct {
@@ -96,21 +182,163 @@ func (p Percentage) Value() int {
 	return p.value
 }
 
+// Fraction returns the percentage as a fraction between 0 and 1.
+func (p Percentage) Fraction() float64 {
+	return float64(p.value) / 100
+}
+
+// String returns the percentage formatted as e.g. "40%".
+func (p Percentage) String() string {
+	return fmt.Sprintf("%d%%", p.value)
+}
+
+// Equal returns true if both percentages have the same value.
+func (p Percentage) Equal(other Percentage) bool {
+	return p.value == other.value
+}
+
+// ClampPercentage creates a Percentage, saturating values outside 0 to 100.
+func ClampPercentage(value int) Percentage {
+	return Percentage{value: max(0, min(100, value))}
+}
+
+// Add returns a new Percentage increased by delta.
+// It returns an error if the result would exceed 100 or fall below 0.
+func (p Percentage) Add(delta int) (Percentage, error) {
+	return NewPercentage(p.value + delta)
+}
+
+// Sub returns a new Percentage decreased by delta.
+// It returns an error if the result would exceed 100 or fall below 0.
+func (p Percentage) Sub(delta int) (Percentage, error) {
+	return NewPercentage(p.value - delta)
+}
+
+// IsComplete returns true if the percentage is 100.
+func (p Percentage) IsComplete() bool {
+	return p.value == 100
+}
+
 // URL represents a validated URL.
 type URL struct {
 	value string
 }
 
 // NewURL creates a validated URL.
+// The URL must be absolute, with both a scheme and a host.
 func NewURL(value string) (URL, error) {
-	_, err := url.ParseRequestURI(value)
-	if err != nil {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
 		return URL{}, NewValidationError("url", "Invalid URL format", "INVALID_URL")
 	}
 	return URL{value: value}, nil
 }
 
+// IsAbsolute returns true if the URL has both a scheme and a host.
+// Every URL created by NewURL is absolute; the zero URL is not.
+func (u URL) IsAbsolute() bool {
+	return u.Scheme() != "" && u.Host() != ""
+}
+
+// NewURLWithSchemes creates a validated URL whose scheme is one of allowed.
+// Schemes are compared case-insensitively.
+func NewURLWithSchemes(value string, allowed ...string) (URL, error) {
+	u, err := NewURL(value)
+	if err != nil {
+		return URL{}, err
+	}
+
+	scheme := u.Scheme()
+	for _, s := range allowed {
+		if strings.EqualFold(scheme, s) {
+			return u, nil
+		}
+	}
+	return URL{}, NewValidationError(
+		"url",
+		"URL scheme must be one of: "+strings.Join(allowed, ", "),
+		"DISALLOWED_SCHEME",
+	)
+}
+
+// Scheme returns the lower-cased URL scheme, e.g. "https".
+func (u URL) Scheme() string {
+	parsed, err := url.Parse(u.value)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Scheme)
+}
+
+// Host returns the URL host, including the port if present.
+func (u URL) Host() string {
+	parsed, err := url.Parse(u.value)
+	if err != nil {
+		return ""
+	}
+	return parsed.Host
+}
+
+// Equal returns true if both URLs have the same string value.
+func (u URL) Equal(other URL) bool {
+	return u.value == other.value
+}
+
 // String returns the URL string.
 func (u URL) String() string {
 	return u.value
 }
+
+// Money represents a monetary amount in a given currency.
+// Amounts are estimates used for risk quantification, so they are kept as float64.
+type Money struct {
+	amount   float64
+	currency string
+}
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// NewMoney creates a validated Money. The amount must be finite and the
+// currency an ISO 4217 code such as "USD".
+func NewMoney(amount float64, currency string) (Money, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return Money{}, NewValidationError("amount", "Amount must be a finite number", "INVALID_AMOUNT")
+	}
+	if !currencyPattern.MatchString(currency) {
+		return Money{}, NewValidationError("currency", "Currency must be a 3-letter ISO 4217 code", "INVALID_CURRENCY")
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Amount returns the monetary amount.
+func (m Money) Amount() float64 {
+	return m.amount
+}
+
+// Currency returns the ISO 4217 currency code.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Equal returns true if both amounts and currencies are the same.
+func (m Money) Equal(other Money) bool {
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+// Add returns the sum of two amounts in the same currency.
+// A sum too large to represent is rejected like a non-finite amount.
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, NewValidationError("currency", "Cannot add amounts in different currencies", "CURRENCY_MISMATCH")
+	}
+	return NewMoney(m.amount+other.amount, m.currency)
+}
+
+// Convert returns the amount converted into another currency,
+// where rate is the number of target units per unit of m's currency.
+func (m Money) Convert(rate float64, currency string) (Money, error) {
+	if rate <= 0 {
+		return Money{}, NewValidationError("rate", "Exchange rate must be positive", "INVALID_RATE")
+	}
+	return NewMoney(m.amount*rate, currency)
+}
@@ -0,0 +1,213 @@
+package shared
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPercentageArithmetic(t *testing.T) {
+	p, err := NewPercentage(60)
+	if err != nil {
+		t.Fatalf("NewPercentage(60) error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		op      func() (Percentage, error)
+		want    int
+		wantErr bool
+	}{
+		{"add within range", func() (Percentage, error) { return p.Add(40) }, 100, false},
+		{"add past 100", func() (Percentage, error) { return p.Add(41) }, 0, true},
+		{"sub within range", func() (Percentage, error) { return p.Sub(60) }, 0, false},
+		{"sub below 0", func() (Percentage, error) { return p.Sub(61) }, 0, true},
+		{"add negative below 0", func() (Percentage, error) { return p.Add(-61) }, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %s, want INVALID_PERCENTAGE", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got.Value() != tt.want {
+				t.Errorf("got %s, want %d%%", got, tt.want)
+			}
+		})
+	}
+
+	if p.Value() != 60 {
+		t.Errorf("arithmetic modified the original percentage: %s", p)
+	}
+}
+
+func TestClampPercentage(t *testing.T) {
+	tests := []struct{ in, want int }{
+		{-20, 0},
+		{0, 0},
+		{55, 55},
+		{100, 100},
+		{150, 100},
+	}
+	for _, tt := range tests {
+		if got := ClampPercentage(tt.in); got.Value() != tt.want {
+			t.Errorf("ClampPercentage(%d) = %s, want %d%%", tt.in, got, tt.want)
+		}
+	}
+	if !ClampPercentage(150).IsComplete() || ClampPercentage(99).IsComplete() {
+		t.Error("IsComplete() should be true only at 100")
+	}
+	if got := ClampPercentage(25).Fraction(); got != 0.25 {
+		t.Errorf("Fraction() = %v, want 0.25", got)
+	}
+}
+
+func TestMoneyRejectsNonFiniteAmounts(t *testing.T) {
+	for _, amount := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewMoney(amount, "USD"); !errors.Is(err, ErrorCode("INVALID_AMOUNT")) {
+			t.Errorf("NewMoney(%v) error = %v, want INVALID_AMOUNT", amount, err)
+		}
+	}
+
+	huge, err := NewMoney(math.MaxFloat64, "USD")
+	if err != nil {
+		t.Fatalf("NewMoney(MaxFloat64) error = %v", err)
+	}
+	if _, err := huge.Add(huge); err == nil {
+		t.Error("Add() overflowing to +Inf error = nil, want INVALID_AMOUNT")
+	}
+}
+
+func TestNewURLWithSchemes(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantCode   string
+		wantScheme string
+		wantHost   string
+	}{
+		{"https://example.com/a.pdf", "", "https", "example.com"},
+		{"HTTP://example.com:8080/a", "", "http", "example.com:8080"},
+		{"ftp://example.com/a.pdf", "DISALLOWED_SCHEME", "", ""},
+		{"/evidence/a.pdf", "INVALID_URL", "", ""},
+		{"evidence/a.pdf", "INVALID_URL", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			u, err := NewURLWithSchemes(tt.value, "https", "http")
+			if tt.wantCode != "" {
+				ve, ok := err.(ValidationError)
+				if !ok || ve.Code != tt.wantCode {
+					t.Fatalf("NewURLWithSchemes() error = %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewURLWithSchemes() error = %v", err)
+			}
+			if u.Scheme() != tt.wantScheme || u.Host() != tt.wantHost {
+				t.Errorf("Scheme(), Host() = %q, %q, want %q, %q", u.Scheme(), u.Host(), tt.wantScheme, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestNewURLRejectsNonAbsolute(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"https://example.com/evidence.pdf", true},
+		{"", false},
+		{"/evidence/report.pdf", false},
+		{"../report.pdf", false},
+		{"mailto:auditor@example.com", false},
+		{"https://", false},
+		{"file:///etc/passwd", false},
+		{"example.com/report.pdf", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			u, err := NewURL(tt.value)
+			if !tt.valid {
+				ve, ok := err.(ValidationError)
+				if !ok || ve.Code != "INVALID_URL" {
+					t.Fatalf("NewURL() error = %v, want INVALID_URL", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewURL() error = %v", err)
+			}
+			if !u.IsAbsolute() {
+				t.Error("IsAbsolute() = false for a valid URL")
+			}
+		})
+	}
+
+	if (URL{}).IsAbsolute() {
+		t.Error("IsAbsolute() = true for the zero URL")
+	}
+}
+
+func TestNewRiskIDWithPrefix(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"risk_01HZX3J6Q8R5T2V7W9Y0A1B2C3", true},
+		{"risk_123e4567-e89b-12d3-a456-426614174000", true},
+		{"ctrl_01HZX3J6Q8R5T2V7W9Y0A1B2C3", false},
+		{"risk_01HZX3J6Q8R5T2V7W9Y0A1B2C", false},
+		{"risk_not-an-id", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			id, err := NewRiskIDWithPrefix(tt.value, RiskIDPrefix)
+			if !tt.valid {
+				ve, ok := err.(ValidationError)
+				if !ok || ve.Code != "INVALID_ID_FORMAT" {
+					t.Fatalf("NewRiskIDWithPrefix() error = %v, want INVALID_ID_FORMAT", err)
+				}
+				return
+			}
+			if err != nil || string(id) != tt.value {
+				t.Fatalf("NewRiskIDWithPrefix() = %q, %v, want %q", id, err, tt.value)
+			}
+		})
+	}
+}
+
+func TestPercentageStringAndFraction(t *testing.T) {
+	tests := []struct {
+		value    int
+		want     string
+		fraction float64
+	}{
+		{0, "0%", 0},
+		{40, "40%", 0.4},
+		{100, "100%", 1},
+	}
+	for _, tt := range tests {
+		p, err := NewPercentage(tt.value)
+		if err != nil {
+			t.Fatalf("NewPercentage(%d) error = %v", tt.value, err)
+		}
+		if got := p.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+		if got := p.Fraction(); got != tt.fraction {
+			t.Errorf("Fraction() = %v, want %v", got, tt.fraction)
+		}
+	}
+}